@@ -0,0 +1,242 @@
+package datadog
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/zorkian/go-datadog-api"
+)
+
+func dataSourceDatadogDowntimes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDatadogDowntimesRead,
+
+		Schema: map[string]*schema.Schema{
+			// Filters
+			"scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"monitor_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"from": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"to": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			// Computed values, sorted by ID
+			"downtimes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"disabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"start": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"end": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"timezone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scope": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"monitor_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"monitor_tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"recurrence": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"period": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"rrule": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"until_date": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"until_occurrences": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"week_days": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// datadogDowntimesFilter holds the data source filters, nil or empty filters
+// match every downtime.
+type datadogDowntimesFilter struct {
+	scope     string
+	monitorID int
+	active    *bool
+	// from and to are POSIX timestamps, downtimes match when they overlap.
+	from, to int
+}
+
+func dataSourceDatadogDowntimesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	downtimes, err := listDowntimes(client)
+	if err != nil {
+		return fmt.Errorf("error querying downtimes: %s", err.Error())
+	}
+
+	filter := datadogDowntimesFilter{
+		scope:     d.Get("scope").(string),
+		monitorID: d.Get("monitor_id").(int),
+		from:      d.Get("from").(int),
+		to:        d.Get("to").(int),
+	}
+	// GetOk can't tell false from unset.
+	if attr, ok := d.GetOkExists("active"); ok {
+		active := attr.(bool)
+		filter.active = &active
+	}
+
+	matches := filterDatadogDowntimes(downtimes, filter)
+	log.Printf("[DEBUG] %d of %d downtimes match %+v", len(matches), len(downtimes), filter)
+
+	ids := []string{}
+	out := []map[string]interface{}{}
+	for _, dt := range matches {
+		ids = append(ids, strconv.Itoa(dt.GetId()))
+		out = append(out, flattenDatadogDowntime(&dt))
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(ids, ","))))
+	if err := d.Set("downtimes", out); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// flattenDatadogDowntime returns the fields of a downtime the way
+// resourceDatadogDowntimeRead sets them.
+func flattenDatadogDowntime(dt *downtime) map[string]interface{} {
+	// ["*"] is the default, every monitor.
+	monitorTags := dt.MonitorTags
+	if len(monitorTags) == 1 && monitorTags[0] == "*" {
+		monitorTags = nil
+	}
+
+	out := map[string]interface{}{
+		"id":           dt.GetId(),
+		"active":       dt.GetActive(),
+		"disabled":     dt.GetDisabled(),
+		"start":        dt.GetStart(),
+		"end":          dt.GetEnd(),
+		"timezone":     dt.GetTimezone(),
+		"message":      dt.GetMessage(),
+		"scope":        dt.Scope,
+		"monitor_id":   dt.GetMonitorId(),
+		"monitor_tags": monitorTags,
+	}
+	if r := dt.Recurrence; r != nil {
+		out["recurrence"] = []map[string]interface{}{flattenDowntimeRecurrence(r)}
+	}
+	return out
+}
+
+// filterDatadogDowntimes returns the downtimes matching all the filters,
+// sorted by ID.
+func filterDatadogDowntimes(downtimes []downtime, filter datadogDowntimesFilter) []downtime {
+	matches := []downtime{}
+	for _, dt := range downtimes {
+		if filter.scope != "" && !downtimeHasScope(&dt, filter.scope) {
+			continue
+		}
+		if filter.monitorID != 0 && dt.GetMonitorId() != filter.monitorID {
+			continue
+		}
+		if filter.active != nil && dt.GetActive() != *filter.active {
+			continue
+		}
+		// Downtimes without an end never end.
+		if filter.from != 0 && dt.GetEnd() != 0 && dt.GetEnd() <= filter.from {
+			continue
+		}
+		if filter.to != 0 && dt.GetStart() >= filter.to {
+			continue
+		}
+		matches = append(matches, dt)
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].GetId() < matches[j].GetId()
+	})
+	return matches
+}
+
+func downtimeHasScope(dt *downtime, scope string) bool {
+	for _, s := range dt.Scope {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,182 @@
+package datadog
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/zorkian/go-datadog-api"
+)
+
+func TestAccDatadogDowntimesDatasource(t *testing.T) {
+	start := time.Now().Unix()
+	config := testAccDowntimesConfig(start)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogDowntimeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				Config: config + testAccDatasourceDowntimesScopeFilterConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.datadog_downtimes.foo", "downtimes.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"data.datadog_downtimes.foo", "downtimes.0.id", "datadog_downtime.now", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.datadog_downtimes.foo", "downtimes.1.id", "datadog_downtime.later", "id"),
+				),
+			},
+			{
+				Config: config + testAccDatasourceDowntimesActiveFilterConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.datadog_downtimes.foo", "downtimes.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.datadog_downtimes.foo", "downtimes.0.id", "datadog_downtime.now", "id"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_downtimes.foo", "downtimes.0.active", "true"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_downtimes.foo", "downtimes.0.start", fmt.Sprint(start)),
+					resource.TestCheckResourceAttr(
+						"data.datadog_downtimes.foo", "downtimes.0.monitor_tags.#", "0"),
+				),
+			},
+			{
+				Config: config + testAccDatasourceDowntimesRangeFilterConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.datadog_downtimes.foo", "downtimes.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.datadog_downtimes.foo", "downtimes.0.id", "datadog_downtime.later", "id"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_downtimes.foo", "downtimes.0.active", "false"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_downtimes.foo", "downtimes.0.scope.0", "host:downtimes-ds"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_downtimes.foo", "downtimes.0.start", "4070840400"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_downtimes.foo", "downtimes.0.end", "4070898000"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_downtimes.foo", "downtimes.0.timezone", "Europe/Paris"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_downtimes.foo", "downtimes.0.message", "Release window."),
+					resource.TestCheckResourceAttr(
+						"data.datadog_downtimes.foo", "downtimes.0.monitor_tags.0", "team:payments"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_downtimes.foo", "downtimes.0.recurrence.0.rrule", "FREQ=MONTHLY;BYDAY=MO;BYSETPOS=1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDowntimesConfig(start int64) string {
+	return fmt.Sprintf(`
+resource "datadog_downtime" "now" {
+  scope  = ["host:downtimes-ds"]
+  start  = %d
+  end    = %d
+  active = true
+}
+
+resource "datadog_downtime" "later" {
+  # Created second, for a stable order of the IDs.
+  depends_on = ["datadog_downtime.now"]
+
+  scope        = ["host:downtimes-ds"]
+  start        = 4070840400
+  end          = 4070898000
+  timezone     = "Europe/Paris"
+  monitor_tags = ["team:payments"]
+  message      = "Release window."
+
+  recurrence {
+    rrule = "FREQ=MONTHLY;BYDAY=MO;BYSETPOS=1"
+  }
+}
+
+resource "datadog_downtime" "other" {
+  scope  = ["host:downtimes-ds-other"]
+  start  = %d
+  end    = %d
+  active = true
+}
+`, start, start+3600, start, start+3600)
+}
+
+const testAccDatasourceDowntimesScopeFilterConfig = `
+data "datadog_downtimes" "foo" {
+  scope = "host:downtimes-ds"
+}
+`
+
+const testAccDatasourceDowntimesActiveFilterConfig = `
+data "datadog_downtimes" "foo" {
+  scope  = "host:downtimes-ds"
+  active = true
+}
+`
+
+const testAccDatasourceDowntimesRangeFilterConfig = `
+data "datadog_downtimes" "foo" {
+  scope = "host:downtimes-ds"
+  from  = 4070800000
+  to    = 4070900000
+}
+`
+
+func TestFilterDatadogDowntimes(t *testing.T) {
+	newDowntime := func(id int, scope string, monitorID, start, end int, active bool) downtime {
+		var dt downtime
+		dt.SetId(id)
+		dt.Scope = []string{scope, "env:prod"}
+		if monitorID != 0 {
+			dt.SetMonitorId(monitorID)
+		}
+		dt.SetStart(start)
+		if end != 0 {
+			dt.SetEnd(end)
+		}
+		dt.SetActive(active)
+		return dt
+	}
+	downtimes := []downtime{
+		newDowntime(4, "host:a", 0, 100, 200, true),
+		newDowntime(2, "host:b", 12, 300, 400, false),
+		newDowntime(3, "host:a", 12, 500, 0, false),
+		newDowntime(1, "host:c", 0, 50, 150, false),
+	}
+
+	cases := []struct {
+		filter   datadogDowntimesFilter
+		expected []int
+	}{
+		{datadogDowntimesFilter{}, []int{1, 2, 3, 4}},
+		{datadogDowntimesFilter{scope: "host:a"}, []int{3, 4}},
+		{datadogDowntimesFilter{scope: "env:prod"}, []int{1, 2, 3, 4}},
+		{datadogDowntimesFilter{scope: "host"}, []int{}},
+		{datadogDowntimesFilter{monitorID: 12}, []int{2, 3}},
+		{datadogDowntimesFilter{active: datadog.Bool(true)}, []int{4}},
+		{datadogDowntimesFilter{active: datadog.Bool(false), scope: "host:a"}, []int{3}},
+		// Downtimes overlapping [from, to), the one without end never ends.
+		{datadogDowntimesFilter{from: 150, to: 300}, []int{4}},
+		{datadogDowntimesFilter{from: 1000}, []int{3}},
+		{datadogDowntimesFilter{to: 100}, []int{1}},
+	}
+
+	for _, tc := range cases {
+		ids := []int{}
+		for _, dt := range filterDatadogDowntimes(downtimes, tc.filter) {
+			ids = append(ids, dt.GetId())
+		}
+		if fmt.Sprint(ids) != fmt.Sprint(tc.expected) {
+			t.Fatalf("filter %+v returned %v, expected %v", tc.filter, ids, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package datadog
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/zorkian/go-datadog-api"
+)
+
+func dataSourceDatadogMonitor() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDatadogMonitorRead,
+
+		Schema: map[string]*schema.Schema{
+			// Filters
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"monitor_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			// Computed values
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"escalation_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"thresholds": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"threshold_windows": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"notify_no_data": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"new_host_delay": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"evaluation_delay": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"no_data_timeframe": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"renotify_interval": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"notify_audit": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"timeout_h": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"require_full_window": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"locked": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"silenced": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"include_tags": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceDatadogMonitorRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	monitors, err := client.GetMonitors()
+	if err != nil {
+		return fmt.Errorf("error querying monitors: %s", err.Error())
+	}
+
+	var nameRegex *regexp.Regexp
+	if attr, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(attr.(string))
+	}
+
+	monitorTags := []string{}
+	if attr, ok := d.GetOk("monitor_tags"); ok {
+		for _, s := range attr.([]interface{}) {
+			monitorTags = append(monitorTags, s.(string))
+		}
+	}
+
+	matches := filterDatadogMonitors(monitors, d.Get("name").(string), nameRegex, monitorTags)
+	if len(matches) == 0 {
+		return fmt.Errorf("your query returned no result, please try a less specific search criteria")
+	}
+	if len(matches) > 1 {
+		return fmt.Errorf("your query returned %d results, please try a more specific search criteria", len(matches))
+	}
+
+	m := matches[0]
+	log.Printf("[DEBUG] monitor: %v", m)
+
+	d.SetId(strconv.Itoa(m.GetId()))
+	updateDatadogMonitorState(d, &m)
+
+	// The API echoes thresholds back as floats, 2 comes back as 2.0. Unlike the
+	// resource there's no configuration to compare with, output them in their
+	// shortest form.
+	thresholds := map[string]string{}
+	for k, v := range d.Get("thresholds").(map[string]interface{}) {
+		thresholds[k] = v.(string)
+		if f, err := strconv.ParseFloat(v.(string), 64); err == nil {
+			thresholds[k] = strconv.FormatFloat(f, 'f', -1, 64)
+		}
+	}
+	d.Set("thresholds", thresholds)
+
+	return nil
+}
+
+// filterDatadogMonitors returns the monitors matching the exact name, the name
+// regex and carrying all of the given tags. Empty filters match everything.
+func filterDatadogMonitors(monitors []datadog.Monitor, name string, nameRegex *regexp.Regexp, tags []string) []datadog.Monitor {
+	matches := []datadog.Monitor{}
+	for _, m := range monitors {
+		if name != "" && m.GetName() != name {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(m.GetName()) {
+			continue
+		}
+		if !monitorHasTags(m, tags) {
+			continue
+		}
+		matches = append(matches, m)
+	}
+	return matches
+}
+
+func monitorHasTags(m datadog.Monitor, tags []string) bool {
+	monitorTags := make(map[string]bool, len(m.Tags))
+	for _, t := range m.Tags {
+		monitorTags[t] = true
+	}
+	for _, t := range tags {
+		if !monitorTags[t] {
+			return false
+		}
+	}
+	return true
+}
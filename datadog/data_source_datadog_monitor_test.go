@@ -0,0 +1,130 @@
+package datadog
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/zorkian/go-datadog-api"
+)
+
+func TestAccDatadogMonitorDatasource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorConfig,
+			},
+			{
+				Config: testAccDatasourceMonitorNameFilterConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.datadog_monitor.foo", "id", "datadog_monitor.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_monitor.foo", "name", "monitor for datasource test"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_monitor.foo", "type", "query alert"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_monitor.foo", "query", "avg(last_1h):avg:aws.ec2.cpu{environment:foo,host:foo} by {host} > 2"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_monitor.foo", "thresholds.critical", "2"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_monitor.foo", "renotify_interval", "60"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_monitor.foo", "tags.#", "2"),
+				),
+			},
+			{
+				Config: testAccDatasourceMonitorTagsFilterConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.datadog_monitor.foo", "id", "datadog_monitor.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_monitor.foo", "name", "monitor for datasource test"),
+				),
+			},
+			{
+				Config:      testAccDatasourceMonitorNoMatchConfig,
+				ExpectError: regexp.MustCompile("your query returned no result"),
+			},
+		},
+	})
+}
+
+const testAccMonitorConfig = `
+resource "datadog_monitor" "foo" {
+  name    = "monitor for datasource test"
+  type    = "query alert"
+  message = "some message Notify: @hipchat-channel"
+
+  query = "avg(last_1h):avg:aws.ec2.cpu{environment:foo,host:foo} by {host} > 2"
+
+  thresholds {
+	critical = 2
+  }
+
+  renotify_interval = 60
+  tags = ["test_datasource_monitor_scope:foo", "test_datasource_monitor:bar"]
+}
+`
+
+const testAccDatasourceMonitorNameFilterConfig = testAccMonitorConfig + `
+data "datadog_monitor" "foo" {
+  name = "${datadog_monitor.foo.name}"
+}
+`
+
+const testAccDatasourceMonitorTagsFilterConfig = testAccMonitorConfig + `
+data "datadog_monitor" "foo" {
+  name_regex   = "^monitor for datasource"
+  monitor_tags = ["${datadog_monitor.foo.tags[0]}", "test_datasource_monitor:bar"]
+}
+`
+
+const testAccDatasourceMonitorNoMatchConfig = testAccMonitorConfig + `
+data "datadog_monitor" "foo" {
+  name         = "${datadog_monitor.foo.name}"
+  monitor_tags = ["test_datasource_monitor:missing"]
+}
+`
+
+func TestFilterDatadogMonitors(t *testing.T) {
+	monitors := []datadog.Monitor{
+		{Id: datadog.Int(1), Name: datadog.String("cpu high"), Tags: []string{"team:a", "env:prod"}},
+		{Id: datadog.Int(2), Name: datadog.String("cpu high staging"), Tags: []string{"team:a", "env:staging"}},
+		{Id: datadog.Int(3), Name: datadog.String("disk full"), Tags: []string{"team:b"}},
+	}
+
+	cases := []struct {
+		name     string
+		regex    *regexp.Regexp
+		tags     []string
+		expected []int
+	}{
+		{"", nil, nil, []int{1, 2, 3}},
+		{"cpu high", nil, nil, []int{1}},
+		{"", regexp.MustCompile("^cpu"), nil, []int{1, 2}},
+		{"", nil, []string{"team:a"}, []int{1, 2}},
+		{"", nil, []string{"team:a", "env:staging"}, []int{2}},
+		{"", regexp.MustCompile("^cpu"), []string{"team:b"}, []int{}},
+		{"memory", nil, nil, []int{}},
+	}
+
+	for _, tc := range cases {
+		matches := filterDatadogMonitors(monitors, tc.name, tc.regex, tc.tags)
+		ids := []int{}
+		for _, m := range matches {
+			ids = append(ids, m.GetId())
+		}
+		if len(ids) != len(tc.expected) {
+			t.Fatalf("filter (%q, %v, %v) returned %v, expected %v", tc.name, tc.regex, tc.tags, ids, tc.expected)
+		}
+		for i := range ids {
+			if ids[i] != tc.expected[i] {
+				t.Fatalf("filter (%q, %v, %v) returned %v, expected %v", tc.name, tc.regex, tc.tags, ids, tc.expected)
+			}
+		}
+	}
+}
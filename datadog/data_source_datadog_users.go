@@ -0,0 +1,137 @@
+package datadog
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/zorkian/go-datadog-api"
+)
+
+func dataSourceDatadogUsers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDatadogUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			// Filters
+			"email_domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"access_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"st", "adm", "ro"}, false),
+			},
+			"disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"verified": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			// Computed values, sorted by handle
+			"handles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"emails": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// datadogUsersFilter holds the data source filters, nil or empty filters match
+// every user.
+type datadogUsersFilter struct {
+	emailDomain string
+	accessRole  string
+	disabled    *bool
+	verified    *bool
+}
+
+func dataSourceDatadogUsersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	users, err := client.GetUsers()
+	if err != nil {
+		return fmt.Errorf("error querying users: %s", err.Error())
+	}
+
+	filter := datadogUsersFilter{
+		emailDomain: d.Get("email_domain").(string),
+		accessRole:  d.Get("access_role").(string),
+	}
+	// GetOk can't tell false from unset.
+	if attr, ok := d.GetOkExists("disabled"); ok {
+		disabled := attr.(bool)
+		filter.disabled = &disabled
+	}
+	if attr, ok := d.GetOkExists("verified"); ok {
+		verified := attr.(bool)
+		filter.verified = &verified
+	}
+
+	matches := filterDatadogUsers(users, filter)
+	log.Printf("[DEBUG] %d of %d users match %+v", len(matches), len(users), filter)
+
+	handles := []string{}
+	names := []string{}
+	emails := []string{}
+	for _, u := range matches {
+		handles = append(handles, u.GetHandle())
+		names = append(names, u.GetName())
+		emails = append(emails, u.GetEmail())
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(handles, ","))))
+	d.Set("handles", handles)
+	d.Set("names", names)
+	d.Set("emails", emails)
+
+	return nil
+}
+
+// filterDatadogUsers returns the users matching all the filters, sorted by
+// handle.
+func filterDatadogUsers(users []datadog.User, filter datadogUsersFilter) []datadog.User {
+	domain := "@" + strings.ToLower(strings.TrimPrefix(filter.emailDomain, "@"))
+
+	matches := []datadog.User{}
+	for _, u := range users {
+		if filter.emailDomain != "" && !strings.HasSuffix(strings.ToLower(u.GetEmail()), domain) {
+			continue
+		}
+		if filter.accessRole != "" && u.GetAccessRole() != filter.accessRole {
+			continue
+		}
+		if filter.disabled != nil && u.GetDisabled() != *filter.disabled {
+			continue
+		}
+		if filter.verified != nil && u.GetVerified() != *filter.verified {
+			continue
+		}
+		matches = append(matches, u)
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].GetHandle() < matches[j].GetHandle()
+	})
+	return matches
+}
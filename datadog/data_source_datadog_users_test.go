@@ -0,0 +1,145 @@
+package datadog
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/zorkian/go-datadog-api"
+)
+
+func TestAccDatadogUsersDatasource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersConfig,
+			},
+			{
+				Config: testAccUsersConfig + testAccDatasourceUsersDomainFilterConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.datadog_users.foo", "handles.#", "3"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_users.foo", "handles.0", "alice@users-ds.example.org"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_users.foo", "names.0", "Alice"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_users.foo", "emails.0", "alice@users-ds.example.org"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_users.foo", "handles.2", "carol@users-ds.example.org"),
+					resource.TestCheckResourceAttr(
+						"datadog_monitor.foo", "message", "Disk full @alice@users-ds.example.org @bob@users-ds.example.org @carol@users-ds.example.org"),
+				),
+			},
+			{
+				Config: testAccUsersConfig + testAccDatasourceUsersRoleFilterConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.datadog_users.foo", "handles.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_users.foo", "handles.0", "bob@users-ds.example.org"),
+				),
+			},
+			{
+				Config: testAccUsersConfig + testAccDatasourceUsersDisabledFilterConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.datadog_users.foo", "handles.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_users.foo", "handles.0", "alice@users-ds.example.org"),
+					resource.TestCheckResourceAttr(
+						"data.datadog_users.foo", "handles.1", "bob@users-ds.example.org"),
+				),
+			},
+		},
+	})
+}
+
+const testAccUsersConfig = `
+resource "datadog_user" "alice" {
+  email  = "alice@users-ds.example.org"
+  handle = "alice@users-ds.example.org"
+  name   = "Alice"
+}
+
+resource "datadog_user" "bob" {
+  email       = "bob@users-ds.example.org"
+  handle      = "bob@users-ds.example.org"
+  name        = "Bob"
+  access_role = "adm"
+}
+
+resource "datadog_user" "carol" {
+  email    = "carol@users-ds.example.org"
+  handle   = "carol@users-ds.example.org"
+  name     = "Carol"
+  disabled = true
+}
+`
+
+const testAccDatasourceUsersDomainFilterConfig = `
+data "datadog_users" "foo" {
+  email_domain = "users-ds.example.org"
+}
+
+resource "datadog_monitor" "foo" {
+  name    = "monitor for users datasource test"
+  type    = "query alert"
+  message = "Disk full @${join(" @", data.datadog_users.foo.handles)}"
+  query   = "avg(last_1h):avg:system.disk.in_use{*} by {host} > 0.9"
+
+  thresholds {
+    critical = 0.9
+  }
+}
+`
+
+const testAccDatasourceUsersRoleFilterConfig = `
+data "datadog_users" "foo" {
+  email_domain = "users-ds.example.org"
+  access_role  = "adm"
+}
+`
+
+const testAccDatasourceUsersDisabledFilterConfig = `
+data "datadog_users" "foo" {
+  email_domain = "users-ds.example.org"
+  disabled     = false
+}
+`
+
+func TestFilterDatadogUsers(t *testing.T) {
+	users := []datadog.User{
+		{Handle: datadog.String("c@example.com"), Email: datadog.String("c@Example.com"), AccessRole: datadog.String("ro"), Disabled: datadog.Bool(true), Verified: datadog.Bool(true)},
+		{Handle: datadog.String("a@example.com"), Email: datadog.String("a@example.com"), AccessRole: datadog.String("st"), Disabled: datadog.Bool(false), Verified: datadog.Bool(true)},
+		{Handle: datadog.String("b@other.com"), Email: datadog.String("b@other.com"), AccessRole: datadog.String("adm"), Disabled: datadog.Bool(false), Verified: datadog.Bool(false)},
+		{Handle: datadog.String("d@sub.example.com"), Email: datadog.String("d@sub.example.com"), AccessRole: datadog.String("st"), Disabled: datadog.Bool(false), Verified: datadog.Bool(false)},
+	}
+
+	cases := []struct {
+		filter   datadogUsersFilter
+		expected []string
+	}{
+		{datadogUsersFilter{}, []string{"a@example.com", "b@other.com", "c@example.com", "d@sub.example.com"}},
+		{datadogUsersFilter{emailDomain: "example.com"}, []string{"a@example.com", "c@example.com"}},
+		{datadogUsersFilter{emailDomain: "@EXAMPLE.com"}, []string{"a@example.com", "c@example.com"}},
+		{datadogUsersFilter{accessRole: "st"}, []string{"a@example.com", "d@sub.example.com"}},
+		{datadogUsersFilter{disabled: datadog.Bool(false)}, []string{"a@example.com", "b@other.com", "d@sub.example.com"}},
+		{datadogUsersFilter{disabled: datadog.Bool(true)}, []string{"c@example.com"}},
+		{datadogUsersFilter{verified: datadog.Bool(false), accessRole: "st"}, []string{"d@sub.example.com"}},
+		{datadogUsersFilter{emailDomain: "nowhere.com"}, []string{}},
+	}
+
+	for _, tc := range cases {
+		handles := []string{}
+		for _, u := range filterDatadogUsers(users, tc.filter) {
+			handles = append(handles, u.GetHandle())
+		}
+		if fmt.Sprint(handles) != fmt.Sprint(tc.expected) {
+			t.Fatalf("filter %+v returned %v, expected %v", tc.filter, handles, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package datadog
+
+import (
+	"fmt"
+
+	"github.com/zorkian/go-datadog-api"
+)
+
+// downtime is a datadog.Downtime with the fields go-datadog-api is missing.
+type downtime struct {
+	datadog.Downtime
+	// Timezone is the IANA time zone recurrences follow, so that they keep
+	// the same local time across DST changes. The API defaults it to UTC.
+	Timezone *string `json:"timezone,omitempty"`
+	// Recurrence shadows the recurrence of datadog.Downtime, whose accessors
+	// must not be used.
+	Recurrence *downtimeRecurrence `json:"recurrence,omitempty"`
+	// MonitorTags silences the monitors having all these tags, the API
+	// defaults it to ["*"], every monitor.
+	MonitorTags []string `json:"monitor_tags,omitempty"`
+}
+
+// downtimeRecurrence is a datadog.Recurrence which can be a recurrence rule,
+// when its type is "rrule".
+type downtimeRecurrence struct {
+	datadog.Recurrence
+	RRule *string `json:"rrule,omitempty"`
+}
+
+func (dt *downtime) GetTimezone() string {
+	if dt.Timezone == nil {
+		return "UTC"
+	}
+	return *dt.Timezone
+}
+
+func createDowntime(client *datadog.Client, dt *downtime) (*downtime, error) {
+	var out downtime
+	if err := doDatadogRequest(client, "POST", "/v1/downtime", nil, dt, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func listDowntimes(client *datadog.Client) ([]downtime, error) {
+	var out []downtime
+	if err := doDatadogRequest(client, "GET", "/v1/downtime", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func getDowntime(client *datadog.Client, id int) (*downtime, error) {
+	var out downtime
+	if err := doDatadogRequest(client, "GET", fmt.Sprintf("/v1/downtime/%d", id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func updateDowntime(client *datadog.Client, dt *downtime) error {
+	return doDatadogRequest(client, "PUT", fmt.Sprintf("/v1/downtime/%d", dt.GetId()), nil, dt, nil)
+}
@@ -0,0 +1,152 @@
+package datadog
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Downtime recurrences can be iCalendar recurrence rules (RFC 5545), e.g.
+// FREQ=MONTHLY;BYSETPOS=1;BYDAY=MO for the first Monday of each month. The
+// start of the downtime is the start of the rule, so DTSTART isn't allowed.
+
+var rruleWeekDayRegexp = regexp.MustCompile(`^([+-]?\d{1,2})?(MO|TU|WE|TH|FR|SA|SU)$`)
+
+// rruleIntList checks a comma separated list of integers, zero excluded, whose
+// absolute values are at most max. Negative values count from the end.
+func rruleIntList(value string, max int, signed bool) error {
+	for _, v := range strings.Split(value, ",") {
+		i, err := strconv.Atoi(v)
+		if err != nil || i == 0 || i > max || i < -max || (!signed && i < 0) {
+			return fmt.Errorf("%q is not a valid value", v)
+		}
+	}
+	return nil
+}
+
+// rrulePositiveInt checks a strictly positive integer.
+func rrulePositiveInt(value string) error {
+	if i, err := strconv.Atoi(value); err != nil || i <= 0 {
+		return fmt.Errorf("%q is not a positive integer", value)
+	}
+	return nil
+}
+
+var rruleParts = map[string]func(string) error{
+	"FREQ": func(v string) error {
+		switch v {
+		case "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+			return nil
+		}
+		return fmt.Errorf("%q is not one of DAILY, WEEKLY, MONTHLY or YEARLY", v)
+	},
+	"INTERVAL": rrulePositiveInt,
+	"COUNT":    rrulePositiveInt,
+	"UNTIL": func(v string) error {
+		if _, err := time.Parse("20060102T150405Z", v); err == nil {
+			return nil
+		}
+		if _, err := time.Parse("20060102", v); err == nil {
+			return nil
+		}
+		return fmt.Errorf("%q is not a date like 20191231 or 20191231T235959Z", v)
+	},
+	"BYDAY": func(v string) error {
+		for _, day := range strings.Split(v, ",") {
+			match := rruleWeekDayRegexp.FindStringSubmatch(day)
+			if match == nil {
+				return fmt.Errorf("%q is not a week day like MO, 1MO or -1FR", day)
+			}
+			if match[1] != "" {
+				if err := rruleIntList(match[1], 53, true); err != nil {
+					return fmt.Errorf("%q is not a week day like MO, 1MO or -1FR", day)
+				}
+			}
+		}
+		return nil
+	},
+	"BYMONTHDAY": func(v string) error { return rruleIntList(v, 31, true) },
+	"BYYEARDAY":  func(v string) error { return rruleIntList(v, 366, true) },
+	"BYWEEKNO":   func(v string) error { return rruleIntList(v, 53, true) },
+	"BYMONTH":    func(v string) error { return rruleIntList(v, 12, false) },
+	"BYSETPOS":   func(v string) error { return rruleIntList(v, 366, true) },
+	"WKST": func(v string) error {
+		if match := rruleWeekDayRegexp.FindStringSubmatch(v); match == nil || match[1] != "" {
+			return fmt.Errorf("%q is not a week day like MO", v)
+		}
+		return nil
+	},
+}
+
+// parseDowntimeRRule validates a recurrence rule and returns it normalized:
+// upper case, without the RRULE: prefix, FREQ first and the other parts
+// sorted, so that equivalent rules compare equal.
+func parseDowntimeRRule(value string) (string, error) {
+	rule := strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(value)), "RRULE:")
+	if rule == "" {
+		return "", fmt.Errorf("the rule is empty")
+	}
+
+	parts := map[string]string{}
+	for _, part := range strings.Split(strings.TrimSuffix(rule, ";"), ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return "", fmt.Errorf("%q is not a NAME=VALUE part", part)
+		}
+		name, v := kv[0], kv[1]
+		if name == "DTSTART" {
+			return "", fmt.Errorf("DTSTART isn't supported, the rule starts with the downtime")
+		}
+		check, ok := rruleParts[name]
+		if !ok {
+			return "", fmt.Errorf("%s isn't supported", name)
+		}
+		if _, ok := parts[name]; ok {
+			return "", fmt.Errorf("%s is set more than once", name)
+		}
+		if err := check(v); err != nil {
+			return "", fmt.Errorf("invalid %s: %s", name, err)
+		}
+		parts[name] = v
+	}
+
+	if _, ok := parts["FREQ"]; !ok {
+		return "", fmt.Errorf("FREQ is required")
+	}
+	_, hasCount := parts["COUNT"]
+	_, hasUntil := parts["UNTIL"]
+	if hasCount && hasUntil {
+		return "", fmt.Errorf("COUNT and UNTIL are mutually exclusive")
+	}
+
+	names := []string{}
+	for name := range parts {
+		if name != "FREQ" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	normalized := []string{"FREQ=" + parts["FREQ"]}
+	for _, name := range names {
+		normalized = append(normalized, name+"="+parts[name])
+	}
+	return strings.Join(normalized, ";"), nil
+}
+
+func validateDatadogDowntimeRRule(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := parseDowntimeRRule(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid recurrence rule %q: %s", k, value, err))
+	}
+	return
+}
+
+// downtimeRRulesEqual reports whether two rules are the same once normalized.
+func downtimeRRulesEqual(a, b string) bool {
+	na, errA := parseDowntimeRRule(a)
+	nb, errB := parseDowntimeRRule(b)
+	return errA == nil && errB == nil && na == nb
+}
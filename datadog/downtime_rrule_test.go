@@ -0,0 +1,48 @@
+package datadog
+
+import (
+	"testing"
+)
+
+func TestParseDowntimeRRule(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected string
+		valid    bool
+	}{
+		{"FREQ=MONTHLY;BYSETPOS=1;BYDAY=MO", "FREQ=MONTHLY;BYDAY=MO;BYSETPOS=1", true},
+		{"RRULE:freq=monthly;byday=1mo", "FREQ=MONTHLY;BYDAY=1MO", true},
+		{"BYDAY=MO,TU,WE,TH,FR;FREQ=MONTHLY;BYSETPOS=-1;", "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", true},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=TU;UNTIL=20301231", "FREQ=WEEKLY;BYDAY=TU;INTERVAL=2;UNTIL=20301231", true},
+		{"FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=-1;COUNT=3", "FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=-1;COUNT=3", true},
+		{"FREQ=DAILY;UNTIL=20301231T235959Z;WKST=SU", "FREQ=DAILY;UNTIL=20301231T235959Z;WKST=SU", true},
+		{"", "", false},
+		{"BYDAY=MO", "", false},
+		{"FREQ=HOURLY", "", false},
+		{"FREQ=DAILY;FREQ=WEEKLY", "", false},
+		{"FREQ=DAILY;COUNT=3;UNTIL=20301231", "", false},
+		{"FREQ=DAILY;COUNT=0", "", false},
+		{"FREQ=DAILY;INTERVAL=two", "", false},
+		{"FREQ=MONTHLY;BYDAY=MONDAY", "", false},
+		{"FREQ=MONTHLY;BYMONTHDAY=32", "", false},
+		{"FREQ=YEARLY;BYMONTH=-1", "", false},
+		{"FREQ=DAILY;UNTIL=2030-12-31", "", false},
+		{"FREQ=DAILY;DTSTART=20300101T000000Z", "", false},
+		{"FREQ=DAILY;BYHOUR=2", "", false},
+		{"FREQ=DAILY;COUNT", "", false},
+		{"FREQ=WEEKLY;WKST=1MO", "", false},
+	}
+
+	for _, tc := range cases {
+		out, err := parseDowntimeRRule(tc.value)
+		if tc.valid && err != nil {
+			t.Fatalf("parsing %q failed: %s", tc.value, err)
+		}
+		if !tc.valid && err == nil {
+			t.Fatalf("parsing %q should have failed, got %q", tc.value, out)
+		}
+		if out != tc.expected {
+			t.Fatalf("parsing %q returned %q, expected %q", tc.value, out, tc.expected)
+		}
+	}
+}
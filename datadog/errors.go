@@ -0,0 +1,125 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// apiError is an error response of the Datadog API.
+type apiError struct {
+	StatusCode int
+	Status     string
+	Body       string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("API error %s: %s", e.Status, e.Body)
+}
+
+// go-datadog-api reports non-2xx responses as untyped errors formatted as
+// "API error <status>: <body>", possibly wrapped by the provider's own messages.
+var apiErrorRegexp = regexp.MustCompile(`API error ((\d{3})[^:]*): ((?s).*)`)
+
+// parseAPIError extracts the API response from err. It returns nil when err
+// is not an error response of the API, e.g. a network error.
+func parseAPIError(err error) *apiError {
+	if err == nil {
+		return nil
+	}
+	if e, ok := err.(*apiError); ok {
+		return e
+	}
+	match := apiErrorRegexp.FindStringSubmatch(err.Error())
+	if match == nil {
+		return nil
+	}
+	code, _ := strconv.Atoi(match[2])
+	return &apiError{StatusCode: code, Status: match[1], Body: match[3]}
+}
+
+func hasAPIStatus(err error, codes ...int) bool {
+	e := parseAPIError(err)
+	if e == nil {
+		return false
+	}
+	for _, code := range codes {
+		if e.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// isNotFoundError reports whether the requested object doesn't exist.
+func isNotFoundError(err error) bool {
+	return hasAPIStatus(err, http.StatusNotFound)
+}
+
+// isRateLimitError reports whether the request was rejected because the
+// organization exceeded its API rate limit.
+func isRateLimitError(err error) bool {
+	return hasAPIStatus(err, http.StatusTooManyRequests)
+}
+
+// isAuthError reports whether the API or application key was rejected or lacks
+// the permissions for the request.
+func isAuthError(err error) bool {
+	return hasAPIStatus(err, http.StatusUnauthorized, http.StatusForbidden)
+}
+
+// isValidationError reports whether the API rejected the request payload.
+func isValidationError(err error) bool {
+	return hasAPIStatus(err, http.StatusBadRequest, http.StatusUnprocessableEntity)
+}
+
+// isConflictError reports whether the object to create already exists.
+func isConflictError(err error) bool {
+	return hasAPIStatus(err, http.StatusConflict)
+}
+
+// describeAPIError explains the API errors whose cause isn't obvious from the
+// response alone. Rate limited requests are retried by rateLimitTransport, the
+// ones that make it here ran out of retry_timeout.
+func describeAPIError(err error) error {
+	switch {
+	case isRateLimitError(err):
+		return fmt.Errorf("%s: rate limited, retry_timeout exceeded: raise retry_timeout, or lower max_concurrent_requests or -parallelism", err)
+	case isValidationError(err):
+		return fmt.Errorf("%s: the Datadog API rejected the request as invalid, check the arguments of the resource", err)
+	}
+	return err
+}
+
+// describeResourceErrors makes the functions of r return their errors through
+// describeAPIError.
+func describeResourceErrors(r *schema.Resource) {
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, meta interface{}) error {
+			return describeAPIError(f(d, meta))
+		}
+	}
+	r.Create = wrap(r.Create)
+	r.Read = wrap(r.Read)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+	if exists := r.Exists; exists != nil {
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			b, err := exists(d, meta)
+			return b, describeAPIError(err)
+		}
+	}
+	if r.Importer != nil && r.Importer.State != nil {
+		state := r.Importer.State
+		r.Importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			ds, err := state(d, meta)
+			return ds, describeAPIError(err)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package datadog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParseAPIError(t *testing.T) {
+	cases := []struct {
+		err    error
+		status int
+		body   string
+	}{
+		{errors.New(`API error 404 Not Found: {"errors": ["Monitor not found"]}`), 404, `{"errors": ["Monitor not found"]}`},
+		{fmt.Errorf("error updating monitor: %s", errors.New(`API error 400 Bad Request: {"errors": ["bad"]}`)), 400, `{"errors": ["bad"]}`},
+		{errors.New("API error 429 Too Many Requests: {\"errors\":\n[\"Rate limit\"]}"), 429, "{\"errors\":\n[\"Rate limit\"]}"},
+		{&apiError{StatusCode: 403, Status: "403 Forbidden", Body: "{}"}, 403, "{}"},
+		{errors.New("dial tcp: connection refused"), 0, ""},
+		{nil, 0, ""},
+	}
+	for _, tc := range cases {
+		e := parseAPIError(tc.err)
+		if tc.status == 0 {
+			if e != nil {
+				t.Errorf("%v: expected no API error, got %v", tc.err, e)
+			}
+			continue
+		}
+		if e == nil {
+			t.Errorf("%v: expected an API error", tc.err)
+			continue
+		}
+		if e.StatusCode != tc.status || e.Body != tc.body {
+			t.Errorf("%v: got status %d and body %q, expected %d and %q", tc.err, e.StatusCode, e.Body, tc.status, tc.body)
+		}
+	}
+}
+
+func TestClassifyAPIError(t *testing.T) {
+	cases := []struct {
+		err                                             error
+		notFound, rateLimit, auth, validation, conflict bool
+	}{
+		{errors.New(`API error 404 Not Found: {}`), true, false, false, false, false},
+		{errors.New(`API error 429 Too Many Requests: {}`), false, true, false, false, false},
+		{errors.New(`API error 401 Unauthorized: {}`), false, false, true, false, false},
+		{errors.New(`API error 403 Forbidden: {}`), false, false, true, false, false},
+		{errors.New(`API error 400 Bad Request: {}`), false, false, false, true, false},
+		{errors.New(`API error 422 Unprocessable Entity: {}`), false, false, false, true, false},
+		{errors.New(`API error 409 Conflict: {}`), false, false, false, false, true},
+		{errors.New(`API error 500 Internal Server Error: {}`), false, false, false, false, false},
+		{errors.New(`monitor 404 is missing`), false, false, false, false, false},
+	}
+	for _, tc := range cases {
+		if isNotFoundError(tc.err) != tc.notFound {
+			t.Errorf("%v: isNotFoundError should be %t", tc.err, tc.notFound)
+		}
+		if isRateLimitError(tc.err) != tc.rateLimit {
+			t.Errorf("%v: isRateLimitError should be %t", tc.err, tc.rateLimit)
+		}
+		if isAuthError(tc.err) != tc.auth {
+			t.Errorf("%v: isAuthError should be %t", tc.err, tc.auth)
+		}
+		if isValidationError(tc.err) != tc.validation {
+			t.Errorf("%v: isValidationError should be %t", tc.err, tc.validation)
+		}
+		if isConflictError(tc.err) != tc.conflict {
+			t.Errorf("%v: isConflictError should be %t", tc.err, tc.conflict)
+		}
+	}
+}
+
+func TestDescribeAPIError(t *testing.T) {
+	cases := []struct {
+		err  error
+		desc string
+	}{
+		{errors.New(`error updating monitor: API error 429 Too Many Requests: {}`), "rate limited, retry_timeout exceeded"},
+		{errors.New(`API error 400 Bad Request: {"errors": ["bad query"]}`), "rejected the request as invalid"},
+		{&apiError{StatusCode: 422, Status: "422 Unprocessable Entity", Body: "{}"}, "rejected the request as invalid"},
+		{errors.New(`API error 500 Internal Server Error: {}`), ""},
+		{nil, ""},
+	}
+	for _, tc := range cases {
+		err := describeAPIError(tc.err)
+		if tc.desc == "" {
+			if err != tc.err {
+				t.Errorf("%v: expected the error to be left as is, got %v", tc.err, err)
+			}
+			continue
+		}
+		if !strings.HasPrefix(err.Error(), tc.err.Error()) || !strings.Contains(err.Error(), tc.desc) {
+			t.Errorf("%v: got %q, expected the error followed by %q", tc.err, err, tc.desc)
+		}
+	}
+}
+
+func TestAccDatadogReadRemovesMissingResources(t *testing.T) {
+	testAccPreCheck(t)
+
+	provider := Provider().(*schema.Provider)
+	if err := provider.Configure(terraform.NewResourceConfig(nil)); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	ids := map[string]string{
+		"datadog_monitor":         "999999999",
+		"datadog_downtime":        "999999999",
+		"datadog_timeboard":       "999999999",
+		"datadog_screenboard":     "999999999",
+		"datadog_dashboard_list":  "999999999",
+		"datadog_user":            "terraform-missing-user@example.com",
+		"datadog_integration_gcp": "terraform-missing-project",
+		"datadog_integration_aws": "000000000000:terraform-missing-role",
+	}
+	for name, id := range ids {
+		r := provider.ResourcesMap[name]
+		d := r.TestResourceData()
+		d.SetId(id)
+		if err := r.Read(d, provider.Meta()); err != nil {
+			t.Errorf("%s: reading a missing resource should not fail: %s", name, err)
+			continue
+		}
+		if d.Id() != "" {
+			t.Errorf("%s: reading a missing resource should remove it from state", name)
+		}
+	}
+}
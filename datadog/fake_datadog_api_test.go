@@ -0,0 +1,1899 @@
+package datadog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	fakeDatadogAPIKey = "fake-api-key"
+	fakeDatadogAppKey = "fake-app-key"
+)
+
+// fakeDatadogAPI is an in-memory stand-in for the parts of the Datadog API used
+// by the provider. It keeps state between requests so that acceptance tests can
+// run offline, and it reproduces the API quirks the provider has to work around.
+type fakeDatadogAPI struct {
+	sync.Mutex
+
+	lastID int
+
+	monitors   map[int]map[string]interface{}
+	downtimes  map[int]map[string]interface{}
+	dashes     map[int]map[string]interface{}
+	screens    map[int]map[string]interface{}
+	lists      map[int]map[string]interface{}
+	listItems  map[int][]map[string]interface{}
+	synthetics map[string]map[string]interface{}
+	slos       map[string]map[string]interface{}
+	users      map[string]map[string]interface{}
+	metrics    map[string]map[string]interface{}
+	hosts      map[string]map[string]interface{}
+	hostTags   map[string]map[string][]string
+
+	logsPipelines     map[string]map[string]interface{}
+	logsPipelineOrder []string
+	logsIndexes       map[string]map[string]interface{}
+	logsIndexOrder    []string
+
+	pagerduty map[string]interface{}
+	slack     map[string]interface{}
+	webhooks  map[string]map[string]interface{}
+	aws       []map[string]interface{}
+	gcp       []map[string]interface{}
+}
+
+func newFakeDatadogAPI() *fakeDatadogAPI {
+	return &fakeDatadogAPI{
+		monitors:   map[int]map[string]interface{}{},
+		downtimes:  map[int]map[string]interface{}{},
+		dashes:     map[int]map[string]interface{}{},
+		screens:    map[int]map[string]interface{}{},
+		lists:      map[int]map[string]interface{}{},
+		listItems:  map[int][]map[string]interface{}{},
+		synthetics: map[string]map[string]interface{}{},
+		slos:       map[string]map[string]interface{}{},
+		users:      map[string]map[string]interface{}{},
+		metrics:    map[string]map[string]interface{}{},
+		hosts:      map[string]map[string]interface{}{},
+		hostTags:   map[string]map[string][]string{},
+		webhooks:   map[string]map[string]interface{}{},
+
+		logsPipelines:     map[string]map[string]interface{}{},
+		logsPipelineOrder: []string{},
+		// Indexes can't be created through the API, the organization starts
+		// with two of them.
+		logsIndexes: map[string]map[string]interface{}{
+			"main":  {"name": "main", "filter": map[string]interface{}{"query": ""}, "exclusion_filters": []interface{}{}},
+			"debug": {"name": "debug", "filter": map[string]interface{}{"query": "status:debug"}, "exclusion_filters": []interface{}{}},
+		},
+		logsIndexOrder: []string{"main", "debug"},
+	}
+}
+
+// fakeRequest holds a decoded API call.
+type fakeRequest struct {
+	method string
+	path   []string
+	query  url.Values
+	body   map[string]interface{}
+}
+
+// fakeResponse is what a handler wants written back.
+type fakeResponse struct {
+	status int
+	body   interface{}
+}
+
+func fakeOK(body interface{}) fakeResponse {
+	return fakeResponse{status: http.StatusOK, body: body}
+}
+
+func fakeError(status int, format string, a ...interface{}) fakeResponse {
+	return fakeResponse{status: status, body: map[string]interface{}{
+		"errors": []string{fmt.Sprintf(format, a...)},
+	}}
+}
+
+func (api *fakeDatadogAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	resp := api.serve(r)
+
+	var body []byte
+	if resp.body != nil {
+		var err error
+		if body, err = json.Marshal(resp.body); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(resp.status)
+	w.Write(body)
+}
+
+func (api *fakeDatadogAPI) serve(r *http.Request) fakeResponse {
+	version := ""
+	for _, v := range []string{"v1", "v2"} {
+		if strings.HasPrefix(r.URL.Path, "/api/"+v+"/") {
+			version = v
+		}
+	}
+	if version == "" {
+		return fakeError(http.StatusNotFound, "unknown endpoint %s", r.URL.Path)
+	}
+
+	q := r.URL.Query()
+	apiKey, appKey := q.Get("api_key"), q.Get("application_key")
+	if apiKey == "" {
+		apiKey = r.Header.Get("DD-API-KEY")
+	}
+	if appKey == "" {
+		appKey = r.Header.Get("DD-APPLICATION-KEY")
+	}
+	path := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/"+version+"/"), "/"), "/")
+
+	if path[0] == "validate" {
+		return fakeOK(map[string]interface{}{"valid": apiKey == fakeDatadogAPIKey})
+	}
+	if apiKey != fakeDatadogAPIKey || appKey != fakeDatadogAppKey {
+		return fakeError(http.StatusForbidden, "Forbidden")
+	}
+
+	req := fakeRequest{method: r.Method, path: path, query: q}
+	if r.Body != nil {
+		var raw interface{}
+		dec := json.NewDecoder(r.Body)
+		dec.UseNumber()
+		if err := dec.Decode(&raw); err == nil {
+			if m, ok := raw.(map[string]interface{}); ok {
+				req.body = m
+			}
+		}
+	}
+	if req.body == nil {
+		req.body = map[string]interface{}{}
+	}
+
+	api.Lock()
+	defer api.Unlock()
+
+	if version == "v2" {
+		if path[0] == "users" {
+			return api.serveUserV2(req)
+		}
+		return fakeError(http.StatusNotFound, "unknown endpoint %s", r.URL.Path)
+	}
+
+	switch path[0] {
+	case "monitor":
+		return api.serveMonitor(req)
+	case "downtime":
+		return api.serveDowntime(req)
+	case "dash":
+		return api.serveDash(req)
+	case "screen":
+		return api.serveScreen(req)
+	case "slo":
+		return api.serveSLO(req)
+	case "synthetics":
+		if len(path) > 1 && path[1] == "tests" {
+			return api.serveSynthetics(req)
+		}
+	case "dashboard":
+		if len(path) > 2 && path[1] == "lists" && path[2] == "manual" {
+			return api.serveDashboardList(req)
+		}
+	case "logs":
+		if len(path) > 2 && path[1] == "config" {
+			switch path[2] {
+			case "pipelines":
+				return api.serveLogsPipeline(req)
+			case "pipeline-order":
+				return api.serveLogsPipelineOrder(req)
+			case "indexes":
+				return api.serveLogsIndex(req)
+			case "index-order":
+				return api.serveLogsIndexOrder(req)
+			}
+		}
+	case "user":
+		return api.serveUser(req)
+	case "metrics":
+		return api.serveMetricMetadata(req)
+	case "host":
+		if len(path) == 3 {
+			return api.serveHostMute(req)
+		}
+	case "hosts":
+		return api.serveHosts(req)
+	case "tags":
+		if len(path) == 3 && path[1] == "hosts" {
+			return api.serveHostTags(req)
+		}
+	case "series":
+		return fakeResponse{status: http.StatusAccepted, body: map[string]interface{}{"status": "ok"}}
+	case "integration":
+		if len(path) > 1 {
+			switch path[1] {
+			case "pagerduty":
+				if len(path) > 3 && path[2] == "configuration" && path[3] == "services" {
+					return api.servePagerdutyService(req)
+				}
+				return api.servePagerduty(req)
+			case "slack":
+				return api.serveSlack(req)
+			case "webhooks":
+				if len(path) > 3 && path[2] == "configuration" && path[3] == "webhooks" {
+					return api.serveWebhook(req)
+				}
+			case "aws":
+				return api.serveAws(req)
+			case "gcp":
+				return api.serveGcp(req)
+			}
+		}
+	}
+	return fakeError(http.StatusNotFound, "unknown endpoint %s %s", r.Method, r.URL.Path)
+}
+
+func (api *fakeDatadogAPI) nextID() int {
+	api.lastID++
+	return api.lastID
+}
+
+// id parses the numeric identifier at index i of the request path.
+func (req fakeRequest) id(i int) (int, bool) {
+	if len(req.path) <= i {
+		return 0, false
+	}
+	id, err := strconv.Atoi(req.path[i])
+	return id, err == nil
+}
+
+// copyJSON deep copies a decoded JSON value so that stored objects never alias
+// request bodies or responses.
+func copyJSON(v map[string]interface{}) map[string]interface{} {
+	b, _ := json.Marshal(v)
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var out map[string]interface{}
+	dec.Decode(&out)
+	return out
+}
+
+// jsonFloat formats a JSON number the way the Datadog API echoes thresholds
+// back: always as a float in its shortest form, so 1 comes back as 1.0 and
+// "1.50" as 1.5.
+func jsonFloat(n json.Number) json.Number {
+	f, err := n.Float64()
+	if err != nil {
+		return n
+	}
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+	return json.Number(s)
+}
+
+func jsonInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		i, err := n.Int64()
+		if err != nil {
+			f, err := n.Float64()
+			if err != nil {
+				return 0, false
+			}
+			return int(f), true
+		}
+		return int(i), true
+	case int:
+		return n, true
+	}
+	return 0, false
+}
+
+/*
+	Monitors
+*/
+
+func (api *fakeDatadogAPI) normalizeMonitor(m map[string]interface{}) {
+	// See https://github.com/hashicorp/terraform/issues/13784: metric alerts
+	// are stored and returned as query alerts.
+	if m["type"] == "metric alert" {
+		m["type"] = "query alert"
+	}
+	// Leading and trailing whitespace is stripped from text fields.
+	for _, k := range []string{"name", "message", "query"} {
+		if s, ok := m[k].(string); ok {
+			m[k] = strings.TrimSpace(s)
+		}
+	}
+	if m["tags"] == nil {
+		m["tags"] = []interface{}{}
+	}
+
+	options, _ := m["options"].(map[string]interface{})
+	if options == nil {
+		options = map[string]interface{}{}
+		m["options"] = options
+	}
+	if s, ok := options["escalation_message"].(string); ok {
+		options["escalation_message"] = strings.TrimSpace(s)
+	}
+	// Windows set to null are removed.
+	if windows, ok := options["threshold_windows"].(map[string]interface{}); ok {
+		for k, v := range windows {
+			if v == nil {
+				delete(windows, k)
+			}
+		}
+		if len(windows) == 0 {
+			delete(options, "threshold_windows")
+		}
+	}
+	if thresholds, ok := options["thresholds"].(map[string]interface{}); ok {
+		for k, v := range thresholds {
+			if n, ok := v.(json.Number); ok {
+				thresholds[k] = jsonFloat(n)
+			}
+		}
+	}
+	for k, v := range map[string]interface{}{
+		"notify_no_data":      false,
+		"notify_audit":        false,
+		"include_tags":        true,
+		"require_full_window": true,
+		"locked":              false,
+		"new_host_delay":      json.Number("300"),
+		"no_data_timeframe":   nil,
+		"silenced":            map[string]interface{}{},
+	} {
+		if _, ok := options[k]; !ok {
+			options[k] = v
+		}
+	}
+}
+
+func (api *fakeDatadogAPI) serveMonitor(req fakeRequest) fakeResponse {
+	if len(req.path) == 1 {
+		switch req.method {
+		case "GET":
+			name := ""
+			if v, ok := req.query["name"]; ok && len(v) > 0 {
+				name = v[0]
+			}
+			tags := []string{}
+			if v, ok := req.query["monitor_tags"]; ok && len(v) > 0 && v[0] != "" {
+				tags = strings.Split(v[0], ",")
+			}
+			ids := []int{}
+			for id := range api.monitors {
+				ids = append(ids, id)
+			}
+			sort.Ints(ids)
+			out := []interface{}{}
+			for _, id := range ids {
+				m := api.monitors[id]
+				if name != "" && !strings.Contains(m["name"].(string), name) {
+					continue
+				}
+				if !fakeHasTags(m["tags"], tags) {
+					continue
+				}
+				out = append(out, m)
+			}
+			return fakeOK(out)
+		case "POST":
+			if err := validateFakeMonitor(req.body); err != "" {
+				return fakeError(http.StatusBadRequest, "%s", err)
+			}
+			m := copyJSON(req.body)
+			id := api.nextID()
+			m["id"] = id
+			api.normalizeMonitor(m)
+			api.monitors[id] = m
+			return fakeOK(m)
+		}
+	}
+
+	id, ok := req.id(1)
+	if !ok {
+		return fakeError(http.StatusNotFound, "Monitor not found")
+	}
+	m, ok := api.monitors[id]
+	if !ok {
+		return fakeError(http.StatusNotFound, "Monitor not found")
+	}
+
+	if len(req.path) == 3 && req.method == "POST" {
+		options := m["options"].(map[string]interface{})
+		switch req.path[2] {
+		case "mute":
+			options["silenced"] = map[string]interface{}{"*": nil}
+			return fakeOK(m)
+		case "unmute":
+			options["silenced"] = map[string]interface{}{}
+			return fakeOK(m)
+		}
+	}
+
+	switch req.method {
+	case "GET":
+		return fakeOK(m)
+	case "PUT":
+		if err := validateFakeMonitor(req.body); err != "" {
+			return fakeError(http.StatusBadRequest, "%s", err)
+		}
+		updated := copyJSON(req.body)
+		updated["id"] = id
+		if _, ok := updated["type"]; !ok {
+			updated["type"] = m["type"]
+		}
+		// Threshold windows left out of an update are kept.
+		if windows, ok := m["options"].(map[string]interface{})["threshold_windows"]; ok {
+			options, _ := updated["options"].(map[string]interface{})
+			if _, ok := options["threshold_windows"]; options != nil && !ok {
+				options["threshold_windows"] = windows
+			}
+		}
+		api.normalizeMonitor(updated)
+		api.monitors[id] = updated
+		return fakeOK(updated)
+	case "DELETE":
+		delete(api.monitors, id)
+		return fakeOK(map[string]interface{}{"deleted_monitor_id": id})
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+// validateFakeMonitor returns the error the API reports for an invalid monitor,
+// or an empty string.
+func validateFakeMonitor(m map[string]interface{}) string {
+	options, _ := m["options"].(map[string]interface{})
+	windows, _ := options["threshold_windows"].(map[string]interface{})
+	query, _ := m["query"].(string)
+	if strings.Contains(query, "anomalies(") {
+		if windows["recovery_window"] == nil || windows["trigger_window"] == nil {
+			return "Threshold windows are required for anomaly monitors"
+		}
+	} else if windows["recovery_window"] != nil || windows["trigger_window"] != nil {
+		return "Threshold windows are only supported for anomaly monitors"
+	}
+	return ""
+}
+
+func fakeHasTags(v interface{}, tags []string) bool {
+	present := map[string]bool{}
+	if list, ok := v.([]interface{}); ok {
+		for _, t := range list {
+			present[fmt.Sprint(t)] = true
+		}
+	}
+	for _, t := range tags {
+		if !present[t] {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+	Downtimes
+*/
+
+// refreshDowntime recomputes the server side fields of a downtime.
+func (api *fakeDatadogAPI) refreshDowntime(dt map[string]interface{}) {
+	now := int(time.Now().Unix())
+	start, ok := jsonInt(dt["start"])
+	if !ok {
+		start = now
+		dt["start"] = start
+	}
+	end, hasEnd := jsonInt(dt["end"])
+	_, canceled := jsonInt(dt["canceled"])
+
+	dt["active"] = !canceled && start <= now && (!hasEnd || now < end)
+	if _, ok := dt["disabled"]; !ok || canceled {
+		dt["disabled"] = canceled
+	}
+	if dt["scope"] == nil {
+		dt["scope"] = []interface{}{}
+	}
+	if dt["timezone"] == nil {
+		dt["timezone"] = "UTC"
+	}
+	if dt["monitor_tags"] == nil {
+		dt["monitor_tags"] = []interface{}{"*"}
+	}
+	if r, ok := dt["recurrence"].(map[string]interface{}); ok && r["type"] == "rrule" {
+		// Rules come back upper case.
+		if rule, ok := r["rrule"].(string); ok {
+			r["rrule"] = strings.ToUpper(rule)
+		}
+	}
+}
+
+// validateFakeDowntime returns the error message the API gives for an invalid
+// downtime, or "".
+func validateFakeDowntime(dt map[string]interface{}) string {
+	if tags, ok := dt["monitor_tags"].([]interface{}); ok && dt["monitor_id"] != nil {
+		if len(tags) != 1 || tags[0] != "*" {
+			return "monitor_id and monitor_tags can't be used together"
+		}
+	}
+	if r, ok := dt["recurrence"].(map[string]interface{}); ok {
+		if r["type"] == "rrule" && r["rrule"] == nil {
+			return "rrule is required when the recurrence type is rrule"
+		}
+		if r["type"] != "rrule" && r["rrule"] != nil {
+			return "rrule is only allowed when the recurrence type is rrule"
+		}
+	}
+	return ""
+}
+
+func (api *fakeDatadogAPI) serveDowntime(req fakeRequest) fakeResponse {
+	if len(req.path) == 1 {
+		switch req.method {
+		case "GET":
+			ids := []int{}
+			for id := range api.downtimes {
+				ids = append(ids, id)
+			}
+			sort.Ints(ids)
+			out := []interface{}{}
+			for _, id := range ids {
+				dt := api.downtimes[id]
+				api.refreshDowntime(dt)
+				out = append(out, dt)
+			}
+			return fakeOK(out)
+		case "POST":
+			dt := copyJSON(req.body)
+			if msg := validateFakeDowntime(dt); msg != "" {
+				return fakeError(http.StatusBadRequest, "%s", msg)
+			}
+			id := api.nextID()
+			dt["id"] = id
+			api.refreshDowntime(dt)
+			api.downtimes[id] = dt
+			api.silenceDowntimeMonitor(dt)
+			return fakeOK(dt)
+		}
+	}
+
+	id, ok := req.id(1)
+	if !ok {
+		return fakeError(http.StatusNotFound, "Downtime not found")
+	}
+	dt, ok := api.downtimes[id]
+	if !ok {
+		return fakeError(http.StatusNotFound, "Downtime not found")
+	}
+
+	switch req.method {
+	case "GET":
+		api.refreshDowntime(dt)
+		return fakeOK(dt)
+	case "PUT":
+		if _, canceled := jsonInt(dt["canceled"]); canceled {
+			return fakeError(http.StatusBadRequest, "Cannot update a canceled downtime")
+		}
+		updated := copyJSON(req.body)
+		if msg := validateFakeDowntime(updated); msg != "" {
+			return fakeError(http.StatusBadRequest, "%s", msg)
+		}
+		updated["id"] = id
+		api.refreshDowntime(updated)
+		api.downtimes[id] = updated
+		api.silenceDowntimeMonitor(updated)
+		return fakeOK(updated)
+	case "DELETE":
+		// Datadog does not delete downtimes, it cancels them and keeps them
+		// around.
+		dt["canceled"] = int(time.Now().Unix())
+		api.refreshDowntime(dt)
+		return fakeResponse{status: http.StatusNoContent}
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+// silenceDowntimeMonitor mimics Datadog setting the `silenced` option of the
+// monitor targeted by a downtime to the end of that downtime.
+func (api *fakeDatadogAPI) silenceDowntimeMonitor(dt map[string]interface{}) {
+	monitorID, ok := jsonInt(dt["monitor_id"])
+	if !ok {
+		return
+	}
+	m, ok := api.monitors[monitorID]
+	if !ok {
+		return
+	}
+	var end interface{}
+	if e, ok := jsonInt(dt["end"]); ok {
+		end = json.Number(strconv.Itoa(e))
+	}
+	m["options"].(map[string]interface{})["silenced"] = map[string]interface{}{"*": end}
+}
+
+/*
+	Timeboards and screenboards
+*/
+
+// fillFakeDashboard mimics the API filling in the defaults of a timeboard or
+// screenboard and giving an ID to each of its graphs or widgets.
+func (api *fakeDatadogAPI) fillFakeDashboard(board map[string]interface{}, items string) {
+	for k, v := range map[string]interface{}{
+		"description":        "",
+		"read_only":          false,
+		"template_variables": []interface{}{},
+	} {
+		if _, ok := board[k]; !ok {
+			board[k] = v
+		}
+	}
+	list, _ := board[items].([]interface{})
+	for _, item := range list {
+		if item, ok := item.(map[string]interface{}); ok {
+			if _, ok := item["id"]; !ok {
+				item["id"] = api.nextID()
+			}
+		}
+	}
+}
+
+func (api *fakeDatadogAPI) serveDash(req fakeRequest) fakeResponse {
+	if len(req.path) == 1 {
+		switch req.method {
+		case "GET":
+			out := []interface{}{}
+			for id, dash := range api.dashes {
+				out = append(out, map[string]interface{}{
+					"id":    strconv.Itoa(id),
+					"title": dash["title"],
+				})
+			}
+			return fakeOK(map[string]interface{}{"dashes": out})
+		case "POST":
+			dash := copyJSON(req.body)
+			id := api.nextID()
+			dash["id"] = id
+			dash["created"] = time.Now().UTC().Format(time.RFC3339)
+			dash["modified"] = dash["created"]
+			api.fillFakeDashboard(dash, "graphs")
+			api.dashes[id] = dash
+			return fakeOK(map[string]interface{}{"dash": dash})
+		}
+	}
+
+	id, ok := req.id(1)
+	if !ok {
+		return fakeError(http.StatusNotFound, "No dashboard matches that dash_id.")
+	}
+	dash, ok := api.dashes[id]
+	if !ok {
+		return fakeError(http.StatusNotFound, "No dashboard matches that dash_id.")
+	}
+
+	switch req.method {
+	case "GET":
+		return fakeOK(map[string]interface{}{"dash": dash})
+	case "PUT":
+		updated := copyJSON(req.body)
+		updated["id"] = id
+		updated["created"] = dash["created"]
+		updated["modified"] = time.Now().UTC().Format(time.RFC3339)
+		api.fillFakeDashboard(updated, "graphs")
+		api.dashes[id] = updated
+		return fakeOK(map[string]interface{}{"dash": updated})
+	case "DELETE":
+		delete(api.dashes, id)
+		return fakeResponse{status: http.StatusNoContent}
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+func (api *fakeDatadogAPI) serveScreen(req fakeRequest) fakeResponse {
+	if len(req.path) == 1 {
+		switch req.method {
+		case "GET":
+			out := []interface{}{}
+			for id, screen := range api.screens {
+				out = append(out, map[string]interface{}{
+					"id":    id,
+					"title": screen["board_title"],
+				})
+			}
+			return fakeOK(map[string]interface{}{"screenboards": out})
+		case "POST":
+			screen := copyJSON(req.body)
+			id := api.nextID()
+			screen["id"] = id
+			api.fillFakeDashboard(screen, "widgets")
+			api.screens[id] = screen
+			return fakeOK(screen)
+		}
+	}
+
+	id, ok := req.id(1)
+	if !ok {
+		return fakeError(http.StatusNotFound, "Screenboard not found")
+	}
+	screen, ok := api.screens[id]
+	if !ok {
+		return fakeError(http.StatusNotFound, "Screenboard not found")
+	}
+
+	switch req.method {
+	case "GET":
+		return fakeOK(screen)
+	case "PUT":
+		updated := copyJSON(req.body)
+		updated["id"] = id
+		api.fillFakeDashboard(updated, "widgets")
+		api.screens[id] = updated
+		return fakeOK(updated)
+	case "DELETE":
+		delete(api.screens, id)
+		return fakeResponse{status: http.StatusNoContent}
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+/*
+	Dashboard lists
+*/
+
+func (api *fakeDatadogAPI) serveDashboardList(req fakeRequest) fakeResponse {
+	if len(req.path) == 3 {
+		switch req.method {
+		case "GET":
+			out := []interface{}{}
+			for _, list := range api.lists {
+				out = append(out, list)
+			}
+			return fakeOK(map[string]interface{}{"dashboard_lists": out})
+		case "POST":
+			id := api.nextID()
+			list := map[string]interface{}{
+				"id":              id,
+				"name":            req.body["name"],
+				"dashboard_count": 0,
+			}
+			api.lists[id] = list
+			api.listItems[id] = []map[string]interface{}{}
+			return fakeOK(list)
+		}
+	}
+
+	id, ok := req.id(3)
+	if !ok {
+		return fakeError(http.StatusNotFound, "Manual Dashboard List %s not found", req.path[3])
+	}
+	list, ok := api.lists[id]
+	if !ok {
+		return fakeError(http.StatusNotFound, "Manual Dashboard List %d not found", id)
+	}
+
+	if len(req.path) == 5 && req.path[4] == "dashboards" {
+		return api.serveDashboardListItems(id, req)
+	}
+
+	switch req.method {
+	case "GET":
+		list["dashboard_count"] = len(api.dashboardListItems(id))
+		return fakeOK(list)
+	case "PUT":
+		list["name"] = req.body["name"]
+		return fakeOK(list)
+	case "DELETE":
+		delete(api.lists, id)
+		delete(api.listItems, id)
+		return fakeOK(map[string]interface{}{"deleted_dashboard_list_id": id})
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+// dashboardListItems returns the items of a list, dropping dashboards that
+// have been deleted since they were added, as the API does.
+func (api *fakeDatadogAPI) dashboardListItems(id int) []map[string]interface{} {
+	items := []map[string]interface{}{}
+	for _, item := range api.listItems[id] {
+		if api.fakeDashboardExists(item) {
+			items = append(items, item)
+		}
+	}
+	api.listItems[id] = items
+	return items
+}
+
+func (api *fakeDatadogAPI) fakeDashboardExists(item map[string]interface{}) bool {
+	id, _ := item["id"].(int)
+	switch item["type"] {
+	case "custom_timeboard":
+		_, ok := api.dashes[id]
+		return ok
+	case "custom_screenboard":
+		_, ok := api.screens[id]
+		return ok
+	}
+	// Integration and host dashboards are not modelled, accept them all.
+	return true
+}
+
+func (api *fakeDatadogAPI) serveDashboardListItems(id int, req fakeRequest) fakeResponse {
+	requested := []map[string]interface{}{}
+	if dashboards, ok := req.body["dashboards"].([]interface{}); ok {
+		for _, d := range dashboards {
+			item, ok := d.(map[string]interface{})
+			if !ok {
+				return fakeError(http.StatusBadRequest, "Invalid dashboard list item")
+			}
+			itemID, _ := jsonInt(item["id"])
+			item = map[string]interface{}{"id": itemID, "type": item["type"]}
+			if !api.fakeDashboardExists(item) {
+				return fakeError(http.StatusNotFound, "Dashboard %v of type %v not found", item["id"], item["type"])
+			}
+			requested = append(requested, item)
+		}
+	}
+
+	items := api.dashboardListItems(id)
+	find := func(item map[string]interface{}) int {
+		for i, existing := range items {
+			if existing["id"] == item["id"] && existing["type"] == item["type"] {
+				return i
+			}
+		}
+		return -1
+	}
+
+	switch req.method {
+	case "GET":
+		return fakeOK(map[string]interface{}{"dashboards": items, "total": len(items)})
+	case "POST":
+		added := []interface{}{}
+		for _, item := range requested {
+			if find(item) < 0 {
+				items = append(items, item)
+				added = append(added, item)
+			}
+		}
+		api.listItems[id] = items
+		return fakeOK(map[string]interface{}{"added_dashboards_to_list": added})
+	case "PUT":
+		api.listItems[id] = requested
+		return fakeOK(map[string]interface{}{"dashboards": requested})
+	case "DELETE":
+		deleted := []interface{}{}
+		for _, item := range requested {
+			if i := find(item); i >= 0 {
+				items = append(items[:i], items[i+1:]...)
+				deleted = append(deleted, item)
+			}
+		}
+		api.listItems[id] = items
+		return fakeOK(map[string]interface{}{"deleted_dashboards_from_list": deleted})
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+/*
+	Service Level Objectives
+*/
+
+func (api *fakeDatadogAPI) validateFakeSLO(slo map[string]interface{}) error {
+	switch slo["type"] {
+	case "monitor":
+		ids, _ := slo["monitor_ids"].([]interface{})
+		if len(ids) == 0 {
+			return fmt.Errorf("monitor_ids is required for monitor SLOs")
+		}
+		for _, id := range ids {
+			i, _ := jsonInt(id)
+			if _, ok := api.monitors[i]; !ok {
+				return fmt.Errorf("monitor %v not found", id)
+			}
+		}
+	case "metric":
+		query, _ := slo["query"].(map[string]interface{})
+		if query == nil || query["numerator"] == "" || query["denominator"] == "" {
+			return fmt.Errorf("query is required for metric SLOs")
+		}
+	default:
+		return fmt.Errorf("type must be monitor or metric")
+	}
+	if thresholds, _ := slo["thresholds"].([]interface{}); len(thresholds) == 0 {
+		return fmt.Errorf("thresholds are required")
+	}
+	return nil
+}
+
+func (api *fakeDatadogAPI) serveSLO(req fakeRequest) fakeResponse {
+	if len(req.path) == 1 && req.method == "POST" {
+		slo := copyJSON(req.body)
+		if err := api.validateFakeSLO(slo); err != nil {
+			return fakeError(http.StatusBadRequest, "%s", err)
+		}
+		id := fmt.Sprintf("%032x", api.nextID())
+		slo["id"] = id
+		api.slos[id] = slo
+		return fakeOK(map[string]interface{}{"data": []interface{}{slo}, "error": nil})
+	}
+
+	if len(req.path) != 2 {
+		return fakeError(http.StatusNotFound, "unknown endpoint")
+	}
+	id := req.path[1]
+	slo, ok := api.slos[id]
+	if !ok {
+		return fakeError(http.StatusNotFound, "SLO not found")
+	}
+
+	switch req.method {
+	case "GET":
+		return fakeOK(map[string]interface{}{"data": slo, "error": nil})
+	case "PUT":
+		updated := copyJSON(req.body)
+		if updated["type"] != slo["type"] {
+			return fakeError(http.StatusBadRequest, "The type of an SLO can't be changed")
+		}
+		if err := api.validateFakeSLO(updated); err != nil {
+			return fakeError(http.StatusBadRequest, "%s", err)
+		}
+		updated["id"] = id
+		api.slos[id] = updated
+		return fakeOK(map[string]interface{}{"data": []interface{}{updated}, "error": nil})
+	case "DELETE":
+		delete(api.slos, id)
+		return fakeOK(map[string]interface{}{"data": []interface{}{id}, "error": nil})
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+/*
+	Synthetics
+*/
+
+var fakeSyntheticsTickEvery = map[string]bool{
+	"60": true, "300": true, "900": true, "1800": true, "3600": true,
+	"21600": true, "43200": true, "86400": true, "604800": true,
+}
+
+func validateFakeSyntheticsTest(test map[string]interface{}) error {
+	config, _ := test["config"].(map[string]interface{})
+	options, _ := test["options"].(map[string]interface{})
+	request, _ := config["request"].(map[string]interface{})
+	if request == nil || request["url"] == nil || request["url"] == "" {
+		return fmt.Errorf("config.request.url is required")
+	}
+	if options == nil || !fakeSyntheticsTickEvery[fmt.Sprint(options["tick_every"])] {
+		return fmt.Errorf("options.tick_every is invalid")
+	}
+	switch test["type"] {
+	case "api":
+		if assertions, _ := config["assertions"].([]interface{}); len(assertions) == 0 {
+			return fmt.Errorf("API tests need at least one assertion")
+		}
+	case "browser":
+		if devices, _ := options["device_ids"].([]interface{}); len(devices) == 0 {
+			return fmt.Errorf("Browser tests need at least one device")
+		}
+	default:
+		return fmt.Errorf("type must be api or browser")
+	}
+	return nil
+}
+
+func (api *fakeDatadogAPI) serveSynthetics(req fakeRequest) fakeResponse {
+	if len(req.path) == 2 && req.method == "POST" {
+		test := copyJSON(req.body)
+		if err := validateFakeSyntheticsTest(test); err != nil {
+			return fakeError(http.StatusBadRequest, "%s", err)
+		}
+		id := api.nextID()
+		publicID := fmt.Sprintf("abc-%03d-xyz", id)
+		test["public_id"] = publicID
+		test["monitor_id"] = id
+		test["status"] = "live"
+		api.synthetics[publicID] = test
+		return fakeOK(test)
+	}
+
+	if len(req.path) == 3 && req.path[2] == "delete" && req.method == "POST" {
+		ids, _ := req.body["public_ids"].([]interface{})
+		deleted := []interface{}{}
+		for _, id := range ids {
+			publicID := fmt.Sprint(id)
+			if _, ok := api.synthetics[publicID]; !ok {
+				return fakeError(http.StatusNotFound, "Synthetics test %s not found", publicID)
+			}
+			delete(api.synthetics, publicID)
+			deleted = append(deleted, map[string]interface{}{"public_id": publicID})
+		}
+		return fakeOK(map[string]interface{}{"deleted_tests": deleted})
+	}
+
+	if len(req.path) < 3 {
+		return fakeError(http.StatusNotFound, "unknown endpoint")
+	}
+	test, ok := api.synthetics[req.path[2]]
+	if !ok {
+		return fakeError(http.StatusNotFound, "Synthetics test not found")
+	}
+
+	if len(req.path) == 4 && req.path[3] == "status" && req.method == "PUT" {
+		status := req.body["new_status"]
+		if status != "live" && status != "paused" {
+			return fakeError(http.StatusBadRequest, "new_status must be live or paused")
+		}
+		test["status"] = status
+		return fakeOK(true)
+	}
+
+	switch req.method {
+	case "GET":
+		return fakeOK(test)
+	case "PUT":
+		updated := copyJSON(req.body)
+		if updated["type"] != test["type"] {
+			return fakeError(http.StatusBadRequest, "The type of a test can't be changed")
+		}
+		if err := validateFakeSyntheticsTest(updated); err != nil {
+			return fakeError(http.StatusBadRequest, "%s", err)
+		}
+		for _, k := range []string{"public_id", "monitor_id", "status"} {
+			updated[k] = test[k]
+		}
+		api.synthetics[req.path[2]] = updated
+		return fakeOK(updated)
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+/*
+	Logs pipelines
+*/
+
+var fakeLogsProcessorTypes = map[string]bool{
+	"grok-parser": true, "attribute-remapper": true, "date-remapper": true,
+	"status-remapper": true, "category-processor": true, "arithmetic-processor": true,
+	"string-builder-processor": true, "url-parser": true, "user-agent-parser": true,
+	"message-remapper": true, "service-remapper": true, "pipeline": true,
+}
+
+func validateFakeLogsPipeline(pipeline map[string]interface{}) error {
+	if name, _ := pipeline["name"].(string); name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if _, ok := pipeline["filter"].(map[string]interface{}); !ok {
+		return fmt.Errorf("filter is required")
+	}
+	processors, _ := pipeline["processors"].([]interface{})
+	for i, p := range processors {
+		processor, _ := p.(map[string]interface{})
+		if !fakeLogsProcessorTypes[fmt.Sprint(processor["type"])] {
+			return fmt.Errorf("processors[%d]: invalid type %v", i, processor["type"])
+		}
+		if processor["type"] == "grok-parser" {
+			grok, _ := processor["grok"].(map[string]interface{})
+			if grok == nil || grok["match_rules"] == "" {
+				return fmt.Errorf("processors[%d]: grok.match_rules is required", i)
+			}
+		}
+	}
+	return nil
+}
+
+func (api *fakeDatadogAPI) serveLogsPipeline(req fakeRequest) fakeResponse {
+	if len(req.path) == 3 && req.method == "POST" {
+		pipeline := copyJSON(req.body)
+		if err := validateFakeLogsPipeline(pipeline); err != nil {
+			return fakeError(http.StatusBadRequest, "%s", err)
+		}
+		id := fmt.Sprintf("pipeline-%d", api.nextID())
+		pipeline["id"] = id
+		pipeline["type"] = "pipeline"
+		pipeline["is_read_only"] = false
+		api.logsPipelines[id] = pipeline
+		api.logsPipelineOrder = append(api.logsPipelineOrder, id)
+		return fakeOK(pipeline)
+	}
+
+	if len(req.path) != 4 {
+		return fakeError(http.StatusNotFound, "unknown endpoint")
+	}
+	id := req.path[3]
+	pipeline, ok := api.logsPipelines[id]
+	if !ok {
+		return fakeError(http.StatusNotFound, "Pipeline not found")
+	}
+
+	switch req.method {
+	case "GET":
+		return fakeOK(pipeline)
+	case "PUT":
+		updated := copyJSON(req.body)
+		if err := validateFakeLogsPipeline(updated); err != nil {
+			return fakeError(http.StatusBadRequest, "%s", err)
+		}
+		for _, k := range []string{"id", "type", "is_read_only"} {
+			updated[k] = pipeline[k]
+		}
+		api.logsPipelines[id] = updated
+		return fakeOK(updated)
+	case "DELETE":
+		delete(api.logsPipelines, id)
+		order := []string{}
+		for _, o := range api.logsPipelineOrder {
+			if o != id {
+				order = append(order, o)
+			}
+		}
+		api.logsPipelineOrder = order
+		return fakeOK(nil)
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+func (api *fakeDatadogAPI) serveLogsPipelineOrder(req fakeRequest) fakeResponse {
+	switch req.method {
+	case "GET":
+		return fakeOK(map[string]interface{}{"pipeline_ids": api.logsPipelineOrder})
+	case "PUT":
+		ids, _ := req.body["pipeline_ids"].([]interface{})
+		order := []string{}
+		seen := map[string]bool{}
+		for _, id := range ids {
+			s := fmt.Sprint(id)
+			if _, ok := api.logsPipelines[s]; !ok || seen[s] {
+				return fakeError(http.StatusUnprocessableEntity, "Invalid pipeline %s", s)
+			}
+			seen[s] = true
+			order = append(order, s)
+		}
+		if len(order) != len(api.logsPipelines) {
+			return fakeError(http.StatusUnprocessableEntity, "The pipeline order must contain all the pipelines")
+		}
+		api.logsPipelineOrder = order
+		return fakeOK(map[string]interface{}{"pipeline_ids": order})
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+/*
+	Logs indexes
+*/
+
+func validateFakeLogsIndex(index map[string]interface{}) error {
+	if _, ok := index["filter"].(map[string]interface{}); !ok {
+		return fmt.Errorf("filter is required")
+	}
+	filters, _ := index["exclusion_filters"].([]interface{})
+	for i, f := range filters {
+		exclusionFilter, _ := f.(map[string]interface{})
+		if name, _ := exclusionFilter["name"].(string); name == "" {
+			return fmt.Errorf("exclusion_filters[%d]: name is required", i)
+		}
+		filter, _ := exclusionFilter["filter"].(map[string]interface{})
+		sampleRate, _ := filter["sample_rate"].(json.Number)
+		rate, err := sampleRate.Float64()
+		if err != nil || rate < 0 || rate > 1 {
+			return fmt.Errorf("exclusion_filters[%d]: sample_rate must be between 0 and 1", i)
+		}
+	}
+	return nil
+}
+
+func (api *fakeDatadogAPI) serveLogsIndex(req fakeRequest) fakeResponse {
+	if len(req.path) == 3 && req.method == "GET" {
+		indexes := []interface{}{}
+		for _, name := range api.logsIndexOrder {
+			indexes = append(indexes, api.logsIndexes[name])
+		}
+		return fakeOK(map[string]interface{}{"indexes": indexes})
+	}
+
+	if len(req.path) != 4 {
+		return fakeError(http.StatusNotFound, "unknown endpoint")
+	}
+	name := req.path[3]
+	index, ok := api.logsIndexes[name]
+	if !ok {
+		return fakeError(http.StatusNotFound, "Index not found")
+	}
+
+	switch req.method {
+	case "GET":
+		return fakeOK(index)
+	case "PUT":
+		updated := copyJSON(req.body)
+		if err := validateFakeLogsIndex(updated); err != nil {
+			return fakeError(http.StatusBadRequest, "%s", err)
+		}
+		if updated["exclusion_filters"] == nil {
+			updated["exclusion_filters"] = []interface{}{}
+		}
+		// The daily limit is kept unless it's disabled explicitly.
+		if updated["disable_daily_limit"] == true {
+			delete(updated, "daily_limit")
+		} else if _, ok := updated["daily_limit"]; !ok && index["daily_limit"] != nil {
+			updated["daily_limit"] = index["daily_limit"]
+		}
+		delete(updated, "disable_daily_limit")
+		updated["name"] = name
+		api.logsIndexes[name] = updated
+		return fakeOK(updated)
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+func (api *fakeDatadogAPI) serveLogsIndexOrder(req fakeRequest) fakeResponse {
+	switch req.method {
+	case "GET":
+		return fakeOK(map[string]interface{}{"index_names": api.logsIndexOrder})
+	case "PUT":
+		names, _ := req.body["index_names"].([]interface{})
+		order := []string{}
+		seen := map[string]bool{}
+		for _, n := range names {
+			s := fmt.Sprint(n)
+			if _, ok := api.logsIndexes[s]; !ok || seen[s] {
+				return fakeError(http.StatusUnprocessableEntity, "Invalid index %s", s)
+			}
+			seen[s] = true
+			order = append(order, s)
+		}
+		if len(order) != len(api.logsIndexes) {
+			return fakeError(http.StatusUnprocessableEntity, "The index order must contain all the indexes")
+		}
+		api.logsIndexOrder = order
+		return fakeOK(map[string]interface{}{"index_names": order})
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+/*
+	Users
+*/
+
+func (api *fakeDatadogAPI) serveUser(req fakeRequest) fakeResponse {
+	if len(req.path) == 1 {
+		switch req.method {
+		case "GET":
+			handles := []string{}
+			for h := range api.users {
+				handles = append(handles, h)
+			}
+			sort.Strings(handles)
+			out := []interface{}{}
+			for _, h := range handles {
+				out = append(out, api.users[h])
+			}
+			return fakeOK(map[string]interface{}{"users": out})
+		case "POST":
+			handle, _ := req.body["handle"].(string)
+			if handle == "" {
+				return fakeError(http.StatusBadRequest, "Missing handle")
+			}
+			if _, ok := api.users[handle]; ok {
+				return fakeError(http.StatusConflict, "User %s already exists", handle)
+			}
+			u := map[string]interface{}{
+				"handle":      handle,
+				"email":       handle,
+				"name":        req.body["name"],
+				"access_role": "st",
+				"role":        nil,
+				"verified":    false,
+				"disabled":    false,
+				"is_admin":    false,
+			}
+			if role, ok := req.body["access_role"]; ok {
+				u["access_role"] = role
+				u["is_admin"] = role == "adm"
+			}
+			api.users[handle] = u
+			return fakeOK(map[string]interface{}{"user": u})
+		}
+	}
+
+	if len(req.path) != 2 {
+		return fakeError(http.StatusNotFound, "User not found")
+	}
+	u, ok := api.users[req.path[1]]
+	if !ok {
+		return fakeError(http.StatusNotFound, "User not found")
+	}
+
+	switch req.method {
+	case "GET":
+		return fakeOK(map[string]interface{}{"user": u})
+	case "PUT":
+		for _, k := range []string{"email", "name", "disabled", "is_admin", "access_role"} {
+			if v, ok := req.body[k]; ok {
+				u[k] = v
+			}
+		}
+		if v, ok := req.body["access_role"]; ok {
+			u["is_admin"] = v == "adm"
+		} else if v, ok := req.body["is_admin"]; ok {
+			if v == true {
+				u["access_role"] = "adm"
+			} else if u["access_role"] == "adm" {
+				u["access_role"] = "st"
+			}
+		}
+		return fakeOK(map[string]interface{}{"user": u})
+	case "DELETE":
+		if u["disabled"] == true {
+			return fakeError(http.StatusBadRequest, "User is already disabled")
+		}
+		// Datadog does not delete users, it disables them.
+		u["disabled"] = true
+		return fakeOK(map[string]interface{}{"message": "User " + req.path[1] + " disabled"})
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+// fakeUserV2ID returns the ID of a user in the v2 users API.
+func fakeUserV2ID(handle string) string {
+	return "user-" + handle
+}
+
+func (api *fakeDatadogAPI) serveUserV2(req fakeRequest) fakeResponse {
+	if len(req.path) == 1 && req.method == "GET" {
+		filter := ""
+		if v, ok := req.query["filter"]; ok && len(v) > 0 {
+			filter = v[0]
+		}
+		handles := []string{}
+		for h := range api.users {
+			if strings.Contains(h, filter) {
+				handles = append(handles, h)
+			}
+		}
+		sort.Strings(handles)
+		// Results come in pages of 10 users by default, 100 at most.
+		size, err := strconv.Atoi(req.query.Get("page[size]"))
+		if err != nil {
+			size = 10
+		}
+		if size > 100 {
+			return fakeError(http.StatusBadRequest, "page[size] must be at most 100")
+		}
+		number, _ := strconv.Atoi(req.query.Get("page[number]"))
+		total := len(handles)
+		if start := number * size; start < len(handles) {
+			handles = handles[start:]
+		} else {
+			handles = nil
+		}
+		if len(handles) > size {
+			handles = handles[:size]
+		}
+		out := []interface{}{}
+		for _, h := range handles {
+			u := api.users[h]
+			out = append(out, map[string]interface{}{
+				"type": "users",
+				"id":   fakeUserV2ID(h),
+				"attributes": map[string]interface{}{
+					"handle":   h,
+					"email":    u["email"],
+					"name":     u["name"],
+					"disabled": u["disabled"],
+				},
+			})
+		}
+		return fakeOK(map[string]interface{}{
+			"data": out,
+			"meta": map[string]interface{}{"page": map[string]interface{}{"total_filtered_count": total}},
+		})
+	}
+
+	if len(req.path) == 2 && req.method == "DELETE" {
+		for h := range api.users {
+			if fakeUserV2ID(h) == req.path[1] {
+				delete(api.users, h)
+				return fakeResponse{status: http.StatusNoContent}
+			}
+		}
+		return fakeError(http.StatusNotFound, "User not found")
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+/*
+	Metric metadata
+*/
+
+func (api *fakeDatadogAPI) serveMetricMetadata(req fakeRequest) fakeResponse {
+	if len(req.path) != 2 {
+		return fakeError(http.StatusNotFound, "metric not found")
+	}
+	name := req.path[1]
+
+	switch req.method {
+	case "GET":
+		m, ok := api.metrics[name]
+		if !ok {
+			return fakeError(http.StatusNotFound, "metric not found")
+		}
+		return fakeOK(m)
+	case "PUT":
+		m, ok := api.metrics[name]
+		if !ok {
+			m = map[string]interface{}{}
+			api.metrics[name] = m
+		}
+		for k, v := range copyJSON(req.body) {
+			m[k] = v
+		}
+		return fakeOK(m)
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+/*
+	Hosts
+*/
+
+// fakeHostMuted reports whether a host is muted, mutes end on their own.
+func fakeHostMuted(h map[string]interface{}) bool {
+	if h["is_muted"] != true {
+		return false
+	}
+	end, ok := h["mute_timeout"].(int64)
+	return !ok || end > time.Now().Unix()
+}
+
+func (api *fakeDatadogAPI) serveHostMute(req fakeRequest) fakeResponse {
+	if req.method != "POST" {
+		return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+	}
+	name := req.path[1]
+	h, ok := api.hosts[name]
+	if !ok {
+		// Hosts show up once they report, here once they are muted.
+		h = map[string]interface{}{"name": name, "is_muted": false, "mute_timeout": nil}
+		api.hosts[name] = h
+	}
+
+	switch req.path[2] {
+	case "mute":
+		if fakeHostMuted(h) && req.body["override"] != true {
+			return fakeError(http.StatusBadRequest, "host:%s is already muted. To mute it anyways, set override to true.", name)
+		}
+		var end interface{}
+		if v, ok := req.body["end"]; ok {
+			e, err := strconv.ParseInt(fmt.Sprint(v), 10, 64)
+			if err != nil || e <= time.Now().Unix() {
+				return fakeError(http.StatusBadRequest, "Invalid end %v: it must be a timestamp in the future", v)
+			}
+			end = e
+		}
+		h["is_muted"] = true
+		h["mute_timeout"] = end
+		return fakeOK(map[string]interface{}{"action": "Muted", "hostname": name, "message": req.body["message"], "end": end})
+	case "unmute":
+		if !fakeHostMuted(h) {
+			return fakeError(http.StatusBadRequest, "host:%s is not muted.", name)
+		}
+		h["is_muted"] = false
+		h["mute_timeout"] = nil
+		return fakeOK(map[string]interface{}{"action": "Unmuted", "hostname": name})
+	}
+	return fakeError(http.StatusNotFound, "unknown endpoint")
+}
+
+func (api *fakeDatadogAPI) serveHosts(req fakeRequest) fakeResponse {
+	if req.method != "GET" {
+		return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+	}
+	filter := req.query.Get("filter")
+	names := []string{}
+	for name := range api.hosts {
+		if strings.Contains(name, filter) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	total := len(names)
+	// Results come in pages of 100 hosts by default, 1000 at most.
+	start, _ := strconv.Atoi(req.query.Get("start"))
+	count, err := strconv.Atoi(req.query.Get("count"))
+	if err != nil {
+		count = 100
+	}
+	if count > 1000 {
+		return fakeError(http.StatusBadRequest, "count must be at most 1000")
+	}
+	if start > len(names) {
+		start = len(names)
+	}
+	if start+count < len(names) {
+		names = names[start : start+count]
+	} else {
+		names = names[start:]
+	}
+	list := []interface{}{}
+	for _, name := range names {
+		h := copyJSON(api.hosts[name])
+		if !fakeHostMuted(api.hosts[name]) {
+			h["is_muted"] = false
+			h["mute_timeout"] = nil
+		}
+		list = append(list, h)
+	}
+	return fakeOK(map[string]interface{}{"host_list": list, "total_matching": total, "total_returned": len(list)})
+}
+
+/*
+	Host tags
+*/
+
+func (api *fakeDatadogAPI) serveHostTags(req fakeRequest) fakeResponse {
+	host := req.path[2]
+	source := req.query.Get("source")
+
+	tags, ok := api.hostTags[host]
+	if !ok {
+		if req.method != "POST" && req.method != "PUT" {
+			return fakeError(http.StatusNotFound, "Host %s not found", host)
+		}
+		tags = map[string][]string{}
+		api.hostTags[host] = tags
+	}
+
+	if req.method == "GET" {
+		out := []string{}
+		for s, t := range tags {
+			if source == "" || s == source {
+				out = append(out, t...)
+			}
+		}
+		sort.Strings(out)
+		return fakeOK(map[string]interface{}{"tags": out})
+	}
+
+	// Changes default to the tags set by users.
+	if source == "" {
+		source = "users"
+	}
+	reqTags := []string{}
+	if raw, ok := req.body["tags"].([]interface{}); ok {
+		for _, t := range raw {
+			reqTags = append(reqTags, fmt.Sprint(t))
+		}
+	}
+
+	switch req.method {
+	case "POST":
+	next:
+		for _, t := range reqTags {
+			for _, existing := range tags[source] {
+				if existing == t {
+					continue next
+				}
+			}
+			tags[source] = append(tags[source], t)
+		}
+		return fakeResponse{status: http.StatusCreated, body: map[string]interface{}{"host": host, "tags": tags[source]}}
+	case "PUT":
+		tags[source] = reqTags
+		return fakeResponse{status: http.StatusCreated, body: map[string]interface{}{"host": host, "tags": tags[source]}}
+	case "DELETE":
+		delete(tags, source)
+		return fakeResponse{status: http.StatusNoContent}
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+/*
+	Integrations
+*/
+
+func (api *fakeDatadogAPI) servePagerduty(req fakeRequest) fakeResponse {
+	switch req.method {
+	case "GET":
+		if api.pagerduty == nil {
+			return fakeError(http.StatusNotFound, "pagerduty not found")
+		}
+		return fakeOK(api.pagerduty)
+	case "POST":
+		// Creating the integration adds services and schedules to the existing
+		// configuration.
+		if api.pagerduty == nil {
+			api.pagerduty = map[string]interface{}{
+				"services":  []interface{}{},
+				"schedules": []interface{}{},
+			}
+		}
+		body := copyJSON(req.body)
+		for _, k := range []string{"services", "schedules"} {
+			if v, ok := body[k].([]interface{}); ok {
+				api.pagerduty[k] = append(api.pagerduty[k].([]interface{}), v...)
+			}
+		}
+		for _, k := range []string{"subdomain", "api_token"} {
+			if v, ok := body[k]; ok {
+				api.pagerduty[k] = v
+			}
+		}
+		return fakeResponse{status: http.StatusNoContent}
+	case "PUT":
+		if api.pagerduty == nil {
+			return fakeError(http.StatusNotFound, "pagerduty not found")
+		}
+		body := copyJSON(req.body)
+		// Services left out of the request are kept, so that they can be
+		// managed one at a time.
+		if _, ok := body["services"]; !ok {
+			body["services"] = api.pagerduty["services"]
+		}
+		if _, ok := body["schedules"]; !ok {
+			body["schedules"] = []interface{}{}
+		}
+		api.pagerduty = body
+		return fakeResponse{status: http.StatusNoContent}
+	case "DELETE":
+		api.pagerduty = nil
+		return fakeResponse{status: http.StatusNoContent}
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+func (api *fakeDatadogAPI) servePagerdutyService(req fakeRequest) fakeResponse {
+	if api.pagerduty == nil {
+		return fakeError(http.StatusNotFound, "pagerduty not found")
+	}
+	services := api.pagerduty["services"].([]interface{})
+	find := func(name string) int {
+		for i, s := range services {
+			if s.(map[string]interface{})["service_name"] == name {
+				return i
+			}
+		}
+		return -1
+	}
+
+	if len(req.path) == 4 && req.method == "POST" {
+		service := copyJSON(req.body)
+		name, _ := service["service_name"].(string)
+		if name == "" || service["service_key"] == nil {
+			return fakeError(http.StatusBadRequest, "service_name and service_key are required")
+		}
+		if find(name) >= 0 {
+			return fakeError(http.StatusConflict, "Service %s already exists", name)
+		}
+		api.pagerduty["services"] = append(services, service)
+		return fakeOK(map[string]interface{}{"service_name": name})
+	}
+
+	if len(req.path) != 5 {
+		return fakeError(http.StatusNotFound, "unknown endpoint")
+	}
+	name := req.path[4]
+	i := find(name)
+	if i < 0 {
+		return fakeError(http.StatusNotFound, "Service %s not found", name)
+	}
+
+	switch req.method {
+	case "GET":
+		return fakeOK(map[string]interface{}{"service_name": name})
+	case "PUT":
+		services[i].(map[string]interface{})["service_key"] = req.body["service_key"]
+		return fakeOK(map[string]interface{}{"service_name": name})
+	case "DELETE":
+		api.pagerduty["services"] = append(services[:i:i], services[i+1:]...)
+		return fakeResponse{status: http.StatusNoContent}
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+func (api *fakeDatadogAPI) serveSlack(req fakeRequest) fakeResponse {
+	switch req.method {
+	case "GET":
+		if api.slack == nil {
+			return fakeError(http.StatusNotFound, "slack not found")
+		}
+		return fakeOK(api.slack)
+	case "POST":
+		if api.slack == nil {
+			api.slack = map[string]interface{}{
+				"service_hooks": []interface{}{},
+				"channels":      []interface{}{},
+			}
+		}
+		body := copyJSON(req.body)
+		for _, k := range []string{"service_hooks", "channels"} {
+			if v, ok := body[k].([]interface{}); ok {
+				api.slack[k] = append(api.slack[k].([]interface{}), v...)
+			}
+		}
+		return fakeResponse{status: http.StatusNoContent}
+	case "PUT":
+		if api.slack == nil {
+			return fakeError(http.StatusNotFound, "slack not found")
+		}
+		body := copyJSON(req.body)
+		for _, k := range []string{"service_hooks", "channels"} {
+			if _, ok := body[k]; !ok {
+				body[k] = []interface{}{}
+			}
+		}
+		api.slack = body
+		return fakeResponse{status: http.StatusNoContent}
+	case "DELETE":
+		api.slack = nil
+		return fakeResponse{status: http.StatusNoContent}
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+func (api *fakeDatadogAPI) serveWebhook(req fakeRequest) fakeResponse {
+	if len(req.path) == 4 && req.method == "POST" {
+		webhook := copyJSON(req.body)
+		name, _ := webhook["name"].(string)
+		if name == "" || webhook["url"] == nil {
+			return fakeError(http.StatusBadRequest, "name and url are required")
+		}
+		if _, ok := api.webhooks[name]; ok {
+			return fakeError(http.StatusConflict, "Webhook %s already exists", name)
+		}
+		if webhook["encode_as"] == nil {
+			webhook["encode_as"] = "json"
+		}
+		api.webhooks[name] = webhook
+		return fakeOK(webhook)
+	}
+
+	if len(req.path) != 5 {
+		return fakeError(http.StatusNotFound, "unknown endpoint")
+	}
+	name := req.path[4]
+	webhook, ok := api.webhooks[name]
+	if !ok {
+		return fakeError(http.StatusNotFound, "Webhook %s not found", name)
+	}
+
+	switch req.method {
+	case "GET":
+		return fakeOK(webhook)
+	case "PUT":
+		for k, v := range copyJSON(req.body) {
+			webhook[k] = v
+		}
+		if webhook["name"] != name {
+			delete(api.webhooks, name)
+			api.webhooks[fmt.Sprint(webhook["name"])] = webhook
+		}
+		return fakeOK(webhook)
+	case "DELETE":
+		delete(api.webhooks, name)
+		return fakeResponse{status: http.StatusNoContent}
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+func (api *fakeDatadogAPI) findAws(accountID, roleName interface{}) int {
+	for i, a := range api.aws {
+		if a["account_id"] == accountID && a["role_name"] == roleName {
+			return i
+		}
+	}
+	return -1
+}
+
+func (api *fakeDatadogAPI) serveAws(req fakeRequest) fakeResponse {
+	switch req.method {
+	case "GET":
+		return fakeOK(map[string]interface{}{"accounts": api.aws})
+	case "POST":
+		body := copyJSON(req.body)
+		if api.findAws(body["account_id"], body["role_name"]) != -1 {
+			return fakeError(http.StatusConflict, "The given AWS account and role name are already configured")
+		}
+		for _, k := range []string{"filter_tags", "host_tags"} {
+			if body[k] == nil {
+				body[k] = []interface{}{}
+			}
+		}
+		if body["account_specific_namespace_rules"] == nil {
+			body["account_specific_namespace_rules"] = map[string]interface{}{}
+		}
+		body["external_id"] = fmt.Sprintf("external-id-%d", api.nextID())
+		api.aws = append(api.aws, body)
+		return fakeOK(map[string]interface{}{"external_id": body["external_id"]})
+	case "PUT":
+		i := api.findAws(req.query.Get("account_id"), req.query.Get("role_name"))
+		if i == -1 {
+			return fakeError(http.StatusBadRequest, "The given AWS account is not configured")
+		}
+		body := copyJSON(req.body)
+		if j := api.findAws(body["account_id"], body["role_name"]); j != -1 && j != i {
+			return fakeError(http.StatusConflict, "The given AWS account and role name are already configured")
+		}
+		for _, k := range []string{"account_id", "role_name", "filter_tags", "host_tags", "account_specific_namespace_rules"} {
+			if v, ok := body[k]; ok && v != nil {
+				api.aws[i][k] = v
+			}
+		}
+		return fakeOK(map[string]interface{}{})
+	case "DELETE":
+		i := api.findAws(req.body["account_id"], req.body["role_name"])
+		if i == -1 {
+			return fakeError(http.StatusBadRequest, "The given AWS account is not configured")
+		}
+		api.aws = append(api.aws[:i], api.aws[i+1:]...)
+		return fakeOK(map[string]interface{}{})
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
+
+func (api *fakeDatadogAPI) findGcp(projectID interface{}) int {
+	for i, g := range api.gcp {
+		if g["project_id"] == projectID {
+			return i
+		}
+	}
+	return -1
+}
+
+func (api *fakeDatadogAPI) serveGcp(req fakeRequest) fakeResponse {
+	if len(req.path) == 3 && req.path[2] == "host_filters" && req.method == "POST" {
+		i := api.findGcp(req.body["project_id"])
+		if i == -1 {
+			return fakeError(http.StatusBadRequest, "The given GCP project is not configured")
+		}
+		hostFilters := req.body["host_filters"]
+		if hostFilters == nil {
+			hostFilters = ""
+		}
+		api.gcp[i]["host_filters"] = hostFilters
+		return fakeOK(map[string]interface{}{})
+	}
+
+	switch req.method {
+	case "GET":
+		out := []interface{}{}
+		for _, g := range api.gcp {
+			out = append(out, map[string]interface{}{
+				"project_id":   g["project_id"],
+				"client_email": g["client_email"],
+				"host_filters": g["host_filters"],
+			})
+		}
+		return fakeOK(out)
+	case "POST":
+		body := copyJSON(req.body)
+		if api.findGcp(body["project_id"]) != -1 {
+			return fakeError(http.StatusConflict, "The given GCP project is already configured")
+		}
+		if body["host_filters"] == nil {
+			body["host_filters"] = ""
+		}
+		api.gcp = append(api.gcp, body)
+		return fakeOK(map[string]interface{}{})
+	case "DELETE":
+		i := api.findGcp(req.body["project_id"])
+		if i == -1 {
+			return fakeError(http.StatusBadRequest, "The given GCP project is not configured")
+		}
+		api.gcp = append(api.gcp[:i], api.gcp[i+1:]...)
+		return fakeOK(map[string]interface{}{})
+	}
+	return fakeError(http.StatusMethodNotAllowed, "method not allowed")
+}
@@ -0,0 +1,51 @@
+package datadog
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/zorkian/go-datadog-api"
+)
+
+// go-datadog-api can mute hosts but can't tell whether a host is muted, this
+// call covers what the datadog_host_mute resource needs.
+
+// hostMuteStatus is the part of a host of the host search we care about.
+type hostMuteStatus struct {
+	Name    string `json:"name"`
+	IsMuted bool   `json:"is_muted"`
+	// MuteTimeout is the end of the mute, nil when it has none.
+	MuteTimeout *int `json:"mute_timeout"`
+}
+
+// hostsPageSize is the largest number of hosts the host search returns at once.
+const hostsPageSize = 1000
+
+// getHostMuteStatus returns the mute status of a host, or nil when no host has
+// that name.
+func getHostMuteStatus(client *datadog.Client, host string) (*hostMuteStatus, error) {
+	// The filter matches substrings of the host name and tags, the host might
+	// be on any page of the results.
+	for start := 0; ; start += hostsPageSize {
+		var out struct {
+			HostList      []hostMuteStatus `json:"host_list"`
+			TotalMatching int              `json:"total_matching"`
+		}
+		query := url.Values{
+			"filter": []string{host},
+			"start":  []string{strconv.Itoa(start)},
+			"count":  []string{strconv.Itoa(hostsPageSize)},
+		}
+		if err := doDatadogRequest(client, "GET", "/v1/hosts", query, nil, &out); err != nil {
+			return nil, err
+		}
+		for _, h := range out.HostList {
+			if h.Name == host {
+				return &h, nil
+			}
+		}
+		if len(out.HostList) == 0 || start+len(out.HostList) >= out.TotalMatching {
+			return nil, nil
+		}
+	}
+}
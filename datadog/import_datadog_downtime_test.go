@@ -29,8 +29,8 @@ func TestDatadogDowntime_import(t *testing.T) {
 const testAccCheckDatadogDowntimeConfigImported = `
 resource "datadog_downtime" "foo" {
   scope = ["host:X", "host:Y"]
-  start = 1735707600
-  end   = 1735765200
+  start = 4070840400
+  end   = 4070898000
 
   message = "Example Datadog downtime message."
 }
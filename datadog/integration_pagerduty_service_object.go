@@ -0,0 +1,44 @@
+package datadog
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/zorkian/go-datadog-api"
+)
+
+// go-datadog-api can only replace all the services of the PagerDuty
+// integration at once, these calls manage one service at a time for the
+// datadog_integration_pagerduty_service_object resource.
+
+// integrationPagerdutyServiceObject is a PagerDuty service. The API never
+// returns the service key.
+type integrationPagerdutyServiceObject struct {
+	ServiceName string `json:"service_name,omitempty"`
+	ServiceKey  string `json:"service_key,omitempty"`
+}
+
+func integrationPagerdutyServiceObjectEndpoint(serviceName string) string {
+	return fmt.Sprintf("/v1/integration/pagerduty/configuration/services/%s", url.PathEscape(serviceName))
+}
+
+func createIntegrationPagerdutyServiceObject(client *datadog.Client, service *integrationPagerdutyServiceObject) error {
+	return doDatadogRequest(client, "POST", "/v1/integration/pagerduty/configuration/services", nil, service, nil)
+}
+
+func getIntegrationPagerdutyServiceObject(client *datadog.Client, serviceName string) (*integrationPagerdutyServiceObject, error) {
+	var out integrationPagerdutyServiceObject
+	if err := doDatadogRequest(client, "GET", integrationPagerdutyServiceObjectEndpoint(serviceName), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func updateIntegrationPagerdutyServiceObject(client *datadog.Client, serviceName, serviceKey string) error {
+	req := integrationPagerdutyServiceObject{ServiceKey: serviceKey}
+	return doDatadogRequest(client, "PUT", integrationPagerdutyServiceObjectEndpoint(serviceName), nil, req, nil)
+}
+
+func deleteIntegrationPagerdutyServiceObject(client *datadog.Client, serviceName string) error {
+	return doDatadogRequest(client, "DELETE", integrationPagerdutyServiceObjectEndpoint(serviceName), nil, nil, nil)
+}
@@ -0,0 +1,53 @@
+package datadog
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/zorkian/go-datadog-api"
+)
+
+// integrationWebhook is a webhook of the webhooks integration, monitors notify
+// it with @webhook-<name>.
+type integrationWebhook struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	// Payload is a JSON template, the default payload is used when it's nil.
+	Payload *string `json:"payload"`
+	// CustomHeaders is a JSON object of header names and values.
+	CustomHeaders *string `json:"custom_headers"`
+	// EncodeAs is "json" or "form".
+	EncodeAs string `json:"encode_as"`
+}
+
+func integrationWebhookEndpoint(name string) string {
+	return fmt.Sprintf("/v1/integration/webhooks/configuration/webhooks/%s", url.PathEscape(name))
+}
+
+func createIntegrationWebhook(client *datadog.Client, webhook *integrationWebhook) (*integrationWebhook, error) {
+	var out integrationWebhook
+	if err := doDatadogRequest(client, "POST", "/v1/integration/webhooks/configuration/webhooks", nil, webhook, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func getIntegrationWebhook(client *datadog.Client, name string) (*integrationWebhook, error) {
+	var out integrationWebhook
+	if err := doDatadogRequest(client, "GET", integrationWebhookEndpoint(name), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func updateIntegrationWebhook(client *datadog.Client, name string, webhook *integrationWebhook) (*integrationWebhook, error) {
+	var out integrationWebhook
+	if err := doDatadogRequest(client, "PUT", integrationWebhookEndpoint(name), nil, webhook, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func deleteIntegrationWebhook(client *datadog.Client, name string) error {
+	return doDatadogRequest(client, "DELETE", integrationWebhookEndpoint(name), nil, nil, nil)
+}
@@ -0,0 +1,70 @@
+package datadog
+
+import (
+	"fmt"
+
+	"github.com/zorkian/go-datadog-api"
+)
+
+// logsIndex is a logs index and the filters deciding which logs it keeps.
+// Indexes can't be created or deleted through the API, only updated.
+type logsIndex struct {
+	Name             string                `json:"name"`
+	Filter           logsFilter            `json:"filter"`
+	ExclusionFilters []logsExclusionFilter `json:"exclusion_filters"`
+	DailyLimit       int                   `json:"daily_limit,omitempty"`
+	// DisableDailyLimit removes the daily limit, updates which leave out
+	// DailyLimit keep the current one.
+	DisableDailyLimit bool `json:"disable_daily_limit,omitempty"`
+}
+
+type logsExclusionFilter struct {
+	Name      string                    `json:"name"`
+	IsEnabled bool                      `json:"is_enabled"`
+	Filter    logsExclusionFilterFilter `json:"filter"`
+}
+
+// logsExclusionFilterFilter selects the logs to exclude, SampleRate is the
+// fraction of them which are excluded.
+type logsExclusionFilterFilter struct {
+	Query      string  `json:"query"`
+	SampleRate float64 `json:"sample_rate"`
+}
+
+type logsIndexOrder struct {
+	IndexNames []string `json:"index_names"`
+}
+
+func getLogsIndex(client *datadog.Client, name string) (*logsIndex, error) {
+	var out logsIndex
+	if err := doDatadogRequest(client, "GET", fmt.Sprintf("/v1/logs/config/indexes/%s", name), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func updateLogsIndex(client *datadog.Client, name string, index *logsIndex) (*logsIndex, error) {
+	var out logsIndex
+	if err := doDatadogRequest(client, "PUT", fmt.Sprintf("/v1/logs/config/indexes/%s", name), nil, index, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func getLogsIndexOrder(client *datadog.Client) (*logsIndexOrder, error) {
+	var out logsIndexOrder
+	if err := doDatadogRequest(client, "GET", "/v1/logs/config/index-order", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// updateLogsIndexOrder reorders the indexes. The API rejects lists which don't
+// hold every index of the organization.
+func updateLogsIndexOrder(client *datadog.Client, order *logsIndexOrder) (*logsIndexOrder, error) {
+	var out logsIndexOrder
+	if err := doDatadogRequest(client, "PUT", "/v1/logs/config/index-order", nil, order, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,122 @@
+package datadog
+
+import (
+	"fmt"
+
+	"github.com/zorkian/go-datadog-api"
+)
+
+// logsPipeline is a logs processing pipeline.
+type logsPipeline struct {
+	ID         string          `json:"id,omitempty"`
+	Name       string          `json:"name"`
+	IsEnabled  bool            `json:"is_enabled"`
+	IsReadOnly bool            `json:"is_read_only,omitempty"`
+	Filter     logsFilter      `json:"filter"`
+	Processors []logsProcessor `json:"processors"`
+}
+
+type logsFilter struct {
+	Query string `json:"query"`
+}
+
+// logsProcessor holds the attributes of every processor type the provider
+// supports, Type tells which of them are relevant.
+type logsProcessor struct {
+	Type      string `json:"type"`
+	Name      string `json:"name,omitempty"`
+	IsEnabled bool   `json:"is_enabled"`
+
+	// grok-parser
+	Source  string         `json:"source,omitempty"`
+	Samples []string       `json:"samples,omitempty"`
+	Grok    *logsGrokRules `json:"grok,omitempty"`
+
+	// attribute-remapper, date-remapper, status-remapper, url-parser and
+	// user-agent-parser
+	Sources []string `json:"sources,omitempty"`
+
+	// attribute-remapper
+	SourceType         string `json:"source_type,omitempty"`
+	TargetType         string `json:"target_type,omitempty"`
+	PreserveSource     *bool  `json:"preserve_source,omitempty"`
+	OverrideOnConflict *bool  `json:"override_on_conflict,omitempty"`
+
+	// attribute-remapper, category-processor, arithmetic-processor,
+	// string-builder-processor, url-parser and user-agent-parser
+	Target string `json:"target,omitempty"`
+
+	// category-processor
+	Categories []logsCategory `json:"categories,omitempty"`
+
+	// arithmetic-processor and string-builder-processor
+	Expression       string `json:"expression,omitempty"`
+	Template         string `json:"template,omitempty"`
+	IsReplaceMissing *bool  `json:"is_replace_missing,omitempty"`
+
+	// url-parser
+	NormalizeEndingSlashes *bool `json:"normalize_ending_slashes,omitempty"`
+
+	// user-agent-parser
+	IsEncoded *bool `json:"is_encoded,omitempty"`
+}
+
+type logsGrokRules struct {
+	SupportRules string `json:"support_rules"`
+	MatchRules   string `json:"match_rules"`
+}
+
+type logsCategory struct {
+	Name   string     `json:"name"`
+	Filter logsFilter `json:"filter"`
+}
+
+type logsPipelineOrder struct {
+	PipelineIDs []string `json:"pipeline_ids"`
+}
+
+func createLogsPipeline(client *datadog.Client, pipeline *logsPipeline) (*logsPipeline, error) {
+	var out logsPipeline
+	if err := doDatadogRequest(client, "POST", "/v1/logs/config/pipelines", nil, pipeline, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func getLogsPipeline(client *datadog.Client, id string) (*logsPipeline, error) {
+	var out logsPipeline
+	if err := doDatadogRequest(client, "GET", fmt.Sprintf("/v1/logs/config/pipelines/%s", id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func updateLogsPipeline(client *datadog.Client, id string, pipeline *logsPipeline) (*logsPipeline, error) {
+	var out logsPipeline
+	if err := doDatadogRequest(client, "PUT", fmt.Sprintf("/v1/logs/config/pipelines/%s", id), nil, pipeline, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func deleteLogsPipeline(client *datadog.Client, id string) error {
+	return doDatadogRequest(client, "DELETE", fmt.Sprintf("/v1/logs/config/pipelines/%s", id), nil, nil, nil)
+}
+
+func getLogsPipelineOrder(client *datadog.Client) (*logsPipelineOrder, error) {
+	var out logsPipelineOrder
+	if err := doDatadogRequest(client, "GET", "/v1/logs/config/pipeline-order", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// updateLogsPipelineOrder reorders the pipelines. The API rejects lists which
+// don't hold every pipeline of the organization.
+func updateLogsPipelineOrder(client *datadog.Client, order *logsPipelineOrder) (*logsPipelineOrder, error) {
+	var out logsPipelineOrder
+	if err := doDatadogRequest(client, "PUT", "/v1/logs/config/pipeline-order", nil, order, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -3,16 +3,18 @@ package datadog
 import (
 	"errors"
 	"log"
+	"time"
 
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform/helper/logging"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	datadog "github.com/zorkian/go-datadog-api"
 )
 
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"api_key": {
 				Type:        schema.TypeString,
@@ -29,41 +31,100 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("DATADOG_HOST", nil),
 			},
+			"validate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to check the API and application keys when the provider is configured.",
+			},
+			"retry_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				Description:  "The time in seconds to keep retrying rate limited and failed API requests.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "The maximum number of API requests in flight, 0 means unlimited.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"datadog_downtime":              resourceDatadogDowntime(),
-			"datadog_metric_metadata":       resourceDatadogMetricMetadata(),
-			"datadog_monitor":               resourceDatadogMonitor(),
-			"datadog_timeboard":             resourceDatadogTimeboard(),
-			"datadog_screenboard":           resourceDatadogScreenboard(),
-			"datadog_user":                  resourceDatadogUser(),
-			"datadog_integration_gcp":       resourceDatadogIntegrationGcp(),
-			"datadog_integration_aws":       resourceDatadogIntegrationAws(),
-			"datadog_integration_pagerduty": resourceDatadogIntegrationPagerduty(),
+			"datadog_dashboard_list":                       resourceDatadogDashboardList(),
+			"datadog_dashboard_json":                       resourceDatadogDashboardJSON(),
+			"datadog_downtime":                             resourceDatadogDowntime(),
+			"datadog_logs_index":                           resourceDatadogLogsIndex(),
+			"datadog_logs_index_order":                     resourceDatadogLogsIndexOrder(),
+			"datadog_logs_pipeline":                        resourceDatadogLogsPipeline(),
+			"datadog_logs_pipeline_order":                  resourceDatadogLogsPipelineOrder(),
+			"datadog_metric_metadata":                      resourceDatadogMetricMetadata(),
+			"datadog_monitor":                              resourceDatadogMonitor(),
+			"datadog_timeboard":                            resourceDatadogTimeboard(),
+			"datadog_screenboard":                          resourceDatadogScreenboard(),
+			"datadog_service_level_objective":              resourceDatadogServiceLevelObjective(),
+			"datadog_synthetics_test":                      resourceDatadogSyntheticsTest(),
+			"datadog_user":                                 resourceDatadogUser(),
+			"datadog_integration_gcp":                      resourceDatadogIntegrationGcp(),
+			"datadog_integration_aws":                      resourceDatadogIntegrationAws(),
+			"datadog_integration_pagerduty":                resourceDatadogIntegrationPagerduty(),
+			"datadog_integration_pagerduty_service_object": resourceDatadogIntegrationPagerdutyServiceObject(),
+			"datadog_integration_slack":                    resourceDatadogIntegrationSlack(),
+			"datadog_integration_webhook":                  resourceDatadogIntegrationWebhook(),
+			"datadog_host_tags":                            resourceDatadogHostTags(),
+			"datadog_host_mute":                            resourceDatadogHostMute(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"datadog_monitor":   dataSourceDatadogMonitor(),
+			"datadog_users":     dataSourceDatadogUsers(),
+			"datadog_downtimes": dataSourceDatadogDowntimes(),
 		},
 
 		ConfigureFunc: providerConfigure,
 	}
+
+	for _, r := range p.ResourcesMap {
+		describeResourceErrors(r)
+	}
+	for _, r := range p.DataSourcesMap {
+		describeResourceErrors(r)
+	}
+
+	return p
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
-	client := datadog.NewClient(d.Get("api_key").(string), d.Get("app_key").(string))
+	apiKey, appKey := d.Get("api_key").(string), d.Get("app_key").(string)
+	client := datadog.NewClient(apiKey, appKey)
 	if apiURL := d.Get("api_url").(string); apiURL != "" {
 		client.SetBaseUrl(apiURL)
 	}
 
-	c := cleanhttp.DefaultClient()
-	c.Transport = logging.NewTransport("Datadog", c.Transport)
+	// Requests are retried by rateLimitTransport, the client's own retries
+	// would come on top of them. It gives up after its first attempt once the
+	// retry timeout is past, a zero timeout would retry forever.
+	retryTimeout := time.Duration(d.Get("retry_timeout").(int)) * time.Second
+	client.RetryTimeout = time.Nanosecond
+
+	c := cleanhttp.DefaultPooledClient()
+	c.Transport = logging.NewTransport("Datadog", &authTransport{
+		apiKey:    apiKey,
+		appKey:    appKey,
+		transport: newRateLimitTransport(c.Transport, retryTimeout, d.Get("max_concurrent_requests").(int)),
+	})
 	client.HttpClient = c
 
+	if !d.Get("validate").(bool) {
+		log.Println("[INFO] Datadog client successfully initialized, skipping validation")
+		return client, nil
+	}
+
 	log.Println("[INFO] Datadog client successfully initialized, now validating...")
-	ok, err := client.Validate()
-	if err != nil {
-		log.Printf("[ERROR] Datadog Client validation error: %v", err)
-		return client, err
-	} else if !ok {
-		err := errors.New(`No valid credential sources found for Datadog Provider. Please see https://terraform.io/docs/providers/datadog/index.html for more information on providing credentials for the Datadog Provider`)
+	if err := validateCredentials(client); err != nil {
 		log.Printf("[ERROR] Datadog Client validation error: %v", err)
 		return client, err
 	}
@@ -71,3 +132,25 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	return client, nil
 }
+
+// validateCredentials checks the API key with the validate endpoint, which
+// ignores the application key, then checks the application key with an
+// inexpensive authenticated call.
+func validateCredentials(client *datadog.Client) error {
+	ok, err := client.Validate()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New(`Invalid Datadog API key: the "api_key" argument (or DATADOG_API_KEY environment variable) is missing or not valid. Please see https://terraform.io/docs/providers/datadog/index.html for more information on providing credentials for the Datadog Provider`)
+	}
+
+	if err := doDatadogRequest(client, "GET", "/v1/dashboard/lists/manual", nil, nil, nil); err != nil {
+		if isAuthError(err) {
+			return errors.New(`Invalid Datadog application key: the "app_key" argument (or DATADOG_APP_KEY environment variable) is missing, not valid, or does not belong to the organization of the API key. Please see https://terraform.io/docs/providers/datadog/index.html for more information on providing credentials for the Datadog Provider`)
+		}
+		return err
+	}
+
+	return nil
+}
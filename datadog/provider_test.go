@@ -1,16 +1,28 @@
 package datadog
 
 import (
+	"flag"
+	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
+	"sync/atomic"
 	"testing"
 
+	tfconfig "github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/zorkian/go-datadog-api"
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
 var testAccProvider *schema.Provider
 
+// testAccFakeAPI is the in-process Datadog API the acceptance tests run against,
+// or nil when they run against a real Datadog organization.
+var testAccFakeAPI *fakeDatadogAPI
+
 func init() {
 	testAccProvider = Provider().(*schema.Provider)
 	testAccProviders = map[string]terraform.ResourceProvider{
@@ -18,6 +30,34 @@ func init() {
 	}
 }
 
+// TestMain runs the acceptance tests against a local fake of the Datadog API,
+// unless TF_ACC is set along with DATADOG_API_KEY and DATADOG_APP_KEY, in which
+// case they run against the real organization those keys belong to.
+func TestMain(m *testing.M) {
+	if os.Getenv("TF_ACC") != "" && os.Getenv("DATADOG_API_KEY") != "" && os.Getenv("DATADOG_APP_KEY") != "" {
+		os.Exit(m.Run())
+	}
+
+	testAccFakeAPI = newFakeDatadogAPI()
+	server := httptest.NewServer(testAccFakeAPI)
+	log.Printf("[INFO] Running acceptance tests against a fake Datadog API at %s", server.URL)
+
+	os.Setenv("TF_ACC", "1")
+	os.Setenv("DATADOG_API_KEY", fakeDatadogAPIKey)
+	os.Setenv("DATADOG_APP_KEY", fakeDatadogAppKey)
+	os.Setenv("DATADOG_HOST", server.URL)
+
+	// resource.Test refuses to run acceptance tests without -v.
+	flag.Parse()
+	if !testing.Verbose() {
+		flag.Set("test.v", "true")
+	}
+
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
+}
+
 func TestProvider(t *testing.T) {
 	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
@@ -36,3 +76,103 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("DATADOG_APP_KEY must be set for acceptance tests")
 	}
 }
+
+func TestProviderConfigureValidatesCredentials(t *testing.T) {
+	testAccPreCheck(t)
+
+	cases := map[string]struct {
+		config map[string]interface{}
+		err    string
+	}{
+		"valid keys": {
+			config: map[string]interface{}{},
+		},
+		"invalid api key": {
+			config: map[string]interface{}{"api_key": "invalid"},
+			err:    "Invalid Datadog API key",
+		},
+		"invalid app key": {
+			config: map[string]interface{}{"app_key": "invalid"},
+			err:    "Invalid Datadog application key",
+		},
+		"validation disabled": {
+			config: map[string]interface{}{"api_key": "invalid", "app_key": "invalid", "validate": false},
+		},
+	}
+	for name, tc := range cases {
+		raw, err := tfconfig.NewRawConfig(tc.config)
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		err = Provider().Configure(terraform.NewResourceConfig(raw))
+		if tc.err == "" && err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+		}
+		if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+			t.Errorf("%s: expected an error containing %q, got %v", name, tc.err, err)
+		}
+	}
+}
+
+func TestProviderConfigureRetriesInOneLayer(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	raw, err := tfconfig.NewRawConfig(map[string]interface{}{
+		"api_key":       "foo",
+		"app_key":       "bar",
+		"api_url":       server.URL,
+		"validate":      false,
+		"retry_timeout": 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider := Provider().(*schema.Provider)
+	if err := provider.Configure(terraform.NewResourceConfig(raw)); err != nil {
+		t.Fatal(err)
+	}
+
+	// The transport gives up as the next retry would end after the timeout.
+	if _, err := provider.Meta().(*datadog.Client).GetMonitor(1); err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls != 1 {
+		t.Errorf("got %d calls, expected the request to be sent once", calls)
+	}
+}
+
+func TestProviderDescribesRateLimitErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Reset", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	raw, err := tfconfig.NewRawConfig(map[string]interface{}{
+		"api_key":       "foo",
+		"app_key":       "bar",
+		"api_url":       server.URL,
+		"validate":      false,
+		"retry_timeout": 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider := Provider().(*schema.Provider)
+	if err := provider.Configure(terraform.NewResourceConfig(raw)); err != nil {
+		t.Fatal(err)
+	}
+
+	r := provider.ResourcesMap["datadog_monitor"]
+	d := r.TestResourceData()
+	d.SetId("1")
+	err = r.Read(d, provider.Meta())
+	if err == nil || !strings.Contains(err.Error(), "rate limited, retry_timeout exceeded") {
+		t.Errorf("got %v, expected a rate limit error", err)
+	}
+}
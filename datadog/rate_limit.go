@@ -0,0 +1,154 @@
+package datadog
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	rateLimitMinBackoff = 1 * time.Second
+	rateLimitMaxBackoff = 30 * time.Second
+)
+
+// rateLimitTransport retries requests rejected by the Datadog API rate limits
+// or failing with transient errors, waiting for as long as the X-RateLimit-Reset
+// header asks to, and bounds the number of requests in flight.
+//
+// Rate limited requests were not processed by the API, so they are retried
+// whatever their method. Server and network errors are only retried for
+// idempotent methods: retrying a POST could create the same object twice.
+type rateLimitTransport struct {
+	transport    http.RoundTripper
+	retryTimeout time.Duration
+
+	// semaphore holds a token per request in flight, it is nil when the
+	// number of concurrent requests is unlimited.
+	semaphore chan struct{}
+}
+
+func newRateLimitTransport(transport http.RoundTripper, retryTimeout time.Duration, maxConcurrentRequests int) *rateLimitTransport {
+	t := &rateLimitTransport{
+		transport:    transport,
+		retryTimeout: retryTimeout,
+	}
+	if maxConcurrentRequests > 0 {
+		t.semaphore = make(chan struct{}, maxConcurrentRequests)
+	}
+	return t
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.semaphore != nil {
+		select {
+		case t.semaphore <- struct{}{}:
+			defer func() { <-t.semaphore }()
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	// Keep the body around so that the request can be sent again.
+	getBody := req.GetBody
+	bodyRead := false
+	if req.Body != nil && getBody == nil {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		getBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(body)), nil
+		}
+		bodyRead = true
+	}
+
+	deadline := time.Now().Add(t.retryTimeout)
+	backoff := rateLimitMinBackoff
+	for attempt := 1; ; attempt++ {
+		r := req
+		if attempt > 1 || bodyRead {
+			r = new(http.Request)
+			*r = *req
+			if getBody != nil {
+				body, err := getBody()
+				if err != nil {
+					return nil, err
+				}
+				r.Body = body
+			}
+		}
+
+		resp, err := t.transport.RoundTrip(r)
+
+		wait, retry := retryDelay(req.Method, resp, err, backoff)
+		if !retry || time.Now().Add(wait).After(deadline) {
+			return resp, err
+		}
+		if resp != nil {
+			log.Printf("[DEBUG] Datadog API %s %s returned %s, retrying in %s", req.Method, req.URL.Path, resp.Status, wait)
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		} else {
+			log.Printf("[DEBUG] Datadog API %s %s failed: %s, retrying in %s", req.Method, req.URL.Path, err, wait)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		}
+
+		if backoff *= 2; backoff > rateLimitMaxBackoff {
+			backoff = rateLimitMaxBackoff
+		}
+	}
+}
+
+// retryDelay tells whether the outcome of a request calls for a retry and how
+// long to wait before it.
+func retryDelay(method string, resp *http.Response, err error, backoff time.Duration) (time.Duration, bool) {
+	if err != nil {
+		return backoff, isIdempotentMethod(method)
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if reset, ok := rateLimitReset(resp); ok {
+			// The period might end right away, waiting a bit anyway keeps
+			// the retries from hammering an API that keeps rejecting them.
+			if reset < rateLimitMinBackoff {
+				reset = rateLimitMinBackoff
+			}
+			return reset, true
+		}
+		return backoff, true
+	}
+	if resp.StatusCode >= 500 {
+		return backoff, isIdempotentMethod(method)
+	}
+	return 0, false
+}
+
+// rateLimitReset returns the time left until the rate limit period ends, as
+// announced by the X-RateLimit-Reset header, or by Retry-After as a fallback.
+func rateLimitReset(resp *http.Response) (time.Duration, bool) {
+	for _, header := range []string{"X-RateLimit-Reset", "Retry-After"} {
+		if seconds, err := strconv.Atoi(resp.Header.Get(header)); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+	}
+	return 0, false
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
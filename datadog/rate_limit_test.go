@@ -0,0 +1,151 @@
+package datadog
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// rateLimitedServer rejects the first limited requests with a 429 and answers
+// the next ones with the body they were sent.
+func rateLimitedServer(limited int32, calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(calls, 1) <= limited {
+			w.Header().Set("X-RateLimit-Limit", "100")
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Write(body)
+	}))
+}
+
+func TestRateLimitTransportRetriesRateLimitedRequests(t *testing.T) {
+	for _, method := range []string{"GET", "POST", "PUT", "DELETE"} {
+		method := method
+		t.Run(method, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+			server := rateLimitedServer(2, &calls)
+			defer server.Close()
+
+			client := &http.Client{Transport: newRateLimitTransport(http.DefaultTransport, time.Minute, 0)}
+			req, _ := http.NewRequest(method, server.URL, bytes.NewReader([]byte(`{"name":"foo"}`)))
+			start := time.Now()
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			elapsed := time.Since(start)
+			body, _ := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("got status %d, expected 200", resp.StatusCode)
+			}
+			if string(body) != `{"name":"foo"}` {
+				t.Errorf("the request body was not sent again, got %q", body)
+			}
+			if calls != 3 {
+				t.Errorf("got %d calls, expected 3", calls)
+			}
+			// The rate limit resets right away, the retries wait anyway.
+			if elapsed < 2*rateLimitMinBackoff {
+				t.Errorf("the retries took %s, expected each of them to wait at least %s", elapsed, rateLimitMinBackoff)
+			}
+		})
+	}
+}
+
+func TestRateLimitTransportGivesUpAfterRetryTimeout(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("X-RateLimit-Reset", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRateLimitTransport(http.DefaultTransport, time.Minute, 0)}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("got status %d, expected 429", resp.StatusCode)
+	}
+	if calls != 1 {
+		t.Errorf("got %d calls, the rate limit resets after the retry timeout so no retry was expected", calls)
+	}
+}
+
+func TestRateLimitTransportDoesNotRetryPostOnServerErrors(t *testing.T) {
+	for method, expected := range map[string]int32{"POST": 1, "PUT": 2} {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+			}
+		}))
+
+		client := &http.Client{Transport: newRateLimitTransport(http.DefaultTransport, time.Minute, 0)}
+		req, _ := http.NewRequest(method, server.URL, bytes.NewReader([]byte(`{}`)))
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s: %s", method, err)
+		}
+		resp.Body.Close()
+		server.Close()
+
+		if calls != expected {
+			t.Errorf("%s: got %d calls, expected %d", method, calls, expected)
+		}
+	}
+}
+
+func TestRateLimitTransportLimitsConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRateLimitTransport(http.DefaultTransport, time.Minute, 2)}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Errorf("got %d concurrent requests, expected at most 2", maxInFlight)
+	}
+}
@@ -0,0 +1,315 @@
+package datadog
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"reflect"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/zorkian/go-datadog-api"
+)
+
+// dashboardJSONServerKeys are the attributes of a dashboard that the API sets
+// itself. They are left out of the state so that exported dashboards can be
+// used as they are.
+var dashboardJSONServerKeys = []string{"id", "new_id", "created", "modified", "created_by", "resource", "url"}
+
+// dashboardJSONDefaults are the top-level attributes the API fills in when a
+// definition leaves them out, with the value it fills them with.
+var dashboardJSONDefaults = map[string]interface{}{
+	"description":        "",
+	"read_only":          false,
+	"template_variables": []interface{}{},
+	"isIntegration":      false,
+	"isShared":           false,
+	"disableCog":         false,
+	"disableEditing":     false,
+}
+
+func resourceDatadogDashboardJSON() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatadogDashboardJSONCreate,
+		Read:   resourceDatadogDashboardJSONRead,
+		Update: resourceDatadogDashboardJSONUpdate,
+		Delete: resourceDatadogDashboardJSONDelete,
+		Exists: resourceDatadogDashboardJSONExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceDatadogDashboardJSONImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"timeboard", "screenboard"}, false),
+			},
+			"dashboard": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.ValidateJsonString,
+				StateFunc: func(v interface{}) string {
+					json, _ := normalizeDashboardJSON(v.(string))
+					return json
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					oldJSON, err := normalizeDashboardJSON(old)
+					if err != nil {
+						return false
+					}
+					newJSON, err := normalizeDashboardJSON(new)
+					if err != nil {
+						return false
+					}
+					return oldJSON == newJSON
+				},
+			},
+		},
+	}
+}
+
+// normalizeDashboardJSON returns a canonical form of a dashboard definition:
+// keys sorted, whitespace removed, server-set attributes, the IDs of graphs
+// and widgets and null values dropped, and whole floats written as integers.
+// A timeboard definition wrapped in a "dash" object, as the API returns it,
+// is unwrapped.
+func normalizeDashboardJSON(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	var dashboard map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &dashboard); err != nil {
+		return "", err
+	}
+	if dash, ok := dashboard["dash"].(map[string]interface{}); ok && len(dashboard) == 1 {
+		dashboard = dash
+	}
+	for _, k := range dashboardJSONServerKeys {
+		delete(dashboard, k)
+	}
+	dropDashboardJSONIDs(dashboard["graphs"])
+	dropDashboardJSONIDs(dashboard["widgets"])
+	b, err := json.Marshal(normalizeJSONValue(dashboard))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// dropDashboardJSONIDs removes the IDs the API gives to a list of graphs or
+// widgets, and to the widgets of group widgets. Other IDs, such as those of
+// the monitors a widget shows, are set by users and kept.
+func dropDashboardJSONIDs(items interface{}) {
+	list, _ := items.([]interface{})
+	for _, item := range list {
+		item, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		delete(item, "id")
+		dropDashboardJSONIDs(item["widgets"])
+		if definition, ok := item["definition"].(map[string]interface{}); ok {
+			dropDashboardJSONIDs(definition["widgets"])
+		}
+	}
+}
+
+func normalizeJSONValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if e == nil {
+				delete(v, k)
+				continue
+			}
+			v[k] = normalizeJSONValue(e)
+		}
+		return v
+	case []interface{}:
+		for i, e := range v {
+			v[i] = normalizeJSONValue(e)
+		}
+		return v
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1<<53 {
+			return int64(v)
+		}
+		return v
+	}
+	return v
+}
+
+// dashboardJSONEndpoint returns the API path of the dashboards of the given
+// type, and of the dashboard with the given ID when it is set.
+func dashboardJSONEndpoint(dashboardType, id string) string {
+	path := "/v1/dash"
+	if dashboardType == "screenboard" {
+		path = "/v1/screen"
+	}
+	if id != "" {
+		path += "/" + id
+	}
+	return path
+}
+
+// buildDashboardJSON decodes the dashboard definition of d into the payload
+// sent to the API, keeping every attribute even those the typed
+// datadog_timeboard and datadog_screenboard resources don't know about.
+func buildDashboardJSON(d *schema.ResourceData) (map[string]interface{}, error) {
+	var dashboard map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("dashboard").(string)), &dashboard); err != nil {
+		return nil, fmt.Errorf("error parsing dashboard: %s", err.Error())
+	}
+	if dash, ok := dashboard["dash"].(map[string]interface{}); ok && len(dashboard) == 1 {
+		dashboard = dash
+	}
+	for _, k := range dashboardJSONServerKeys {
+		delete(dashboard, k)
+	}
+	return dashboard, nil
+}
+
+// getDashboardJSON fetches a dashboard as a generic JSON object.
+func getDashboardJSON(client *datadog.Client, dashboardType, id string) (map[string]interface{}, error) {
+	var out map[string]interface{}
+	if err := doDatadogRequest(client, "GET", dashboardJSONEndpoint(dashboardType, id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	if dash, ok := out["dash"].(map[string]interface{}); ok {
+		return dash, nil
+	}
+	return out, nil
+}
+
+func resourceDatadogDashboardJSONCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+	dashboardType := d.Get("type").(string)
+
+	dashboard, err := buildDashboardJSON(d)
+	if err != nil {
+		return err
+	}
+
+	var out map[string]interface{}
+	if err := doDatadogRequest(client, "POST", dashboardJSONEndpoint(dashboardType, ""), nil, dashboard, &out); err != nil {
+		return fmt.Errorf("error creating %s: %s", dashboardType, err.Error())
+	}
+	if dash, ok := out["dash"].(map[string]interface{}); ok {
+		out = dash
+	}
+
+	id, ok := out["id"].(float64)
+	if !ok {
+		return fmt.Errorf("error creating %s: the API returned no dashboard ID", dashboardType)
+	}
+	d.SetId(strconv.FormatInt(int64(id), 10))
+
+	return resourceDatadogDashboardJSONRead(d, meta)
+}
+
+func resourceDatadogDashboardJSONRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+	dashboardType := d.Get("type").(string)
+
+	dashboard, err := getDashboardJSON(client, dashboardType, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] %s %s not found, removing it from state", dashboardType, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// The API fills in defaults for the attributes a definition leaves out,
+	// they are dropped unless the configuration sets them. Any other value
+	// is kept, so that changes made outside of Terraform show up.
+	var configured map[string]interface{}
+	json.Unmarshal([]byte(d.Get("dashboard").(string)), &configured)
+	for k, v := range dashboardJSONDefaults {
+		if _, ok := configured[k]; !ok && reflect.DeepEqual(dashboard[k], v) {
+			delete(dashboard, k)
+		}
+	}
+
+	b, err := json.Marshal(dashboard)
+	if err != nil {
+		return err
+	}
+	normalized, err := normalizeDashboardJSON(string(b))
+	if err != nil {
+		return err
+	}
+	d.Set("dashboard", normalized)
+
+	return nil
+}
+
+func resourceDatadogDashboardJSONUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+	dashboardType := d.Get("type").(string)
+
+	dashboard, err := buildDashboardJSON(d)
+	if err != nil {
+		return err
+	}
+
+	if err := doDatadogRequest(client, "PUT", dashboardJSONEndpoint(dashboardType, d.Id()), nil, dashboard, nil); err != nil {
+		return fmt.Errorf("error updating %s: %s", dashboardType, err.Error())
+	}
+
+	return resourceDatadogDashboardJSONRead(d, meta)
+}
+
+func resourceDatadogDashboardJSONDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+	dashboardType := d.Get("type").(string)
+
+	if err := doDatadogRequest(client, "DELETE", dashboardJSONEndpoint(dashboardType, d.Id()), nil, nil, nil); err != nil {
+		return fmt.Errorf("error deleting %s: %s", dashboardType, err.Error())
+	}
+
+	return nil
+}
+
+func resourceDatadogDashboardJSONExists(d *schema.ResourceData, meta interface{}) (b bool, e error) {
+	client := meta.(*datadog.Client)
+
+	if _, err := getDashboardJSON(client, d.Get("type").(string), d.Id()); err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// resourceDatadogDashboardJSONImport imports a dashboard from an ID of the
+// form "timeboard:<id>" or "screenboard:<id>".
+func resourceDatadogDashboardJSONImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	dashboardType, id, err := dashboardTypeAndIDFromImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(id)
+	d.Set("type", dashboardType)
+	return []*schema.ResourceData{d}, nil
+}
+
+func dashboardTypeAndIDFromImportID(importID string) (string, string, error) {
+	for _, dashboardType := range []string{"timeboard", "screenboard"} {
+		prefix := dashboardType + ":"
+		if len(importID) > len(prefix) && importID[:len(prefix)] == prefix {
+			id := importID[len(prefix):]
+			if _, err := strconv.Atoi(id); err != nil {
+				break
+			}
+			return dashboardType, id, nil
+		}
+	}
+	return "", "", fmt.Errorf(`error importing a dashboard from %q: expected "timeboard:<id>" or "screenboard:<id>"`, importID)
+}
@@ -0,0 +1,294 @@
+package datadog
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	datadog "github.com/zorkian/go-datadog-api"
+)
+
+const testAccCheckDatadogDashboardJSONTimeboardConfig = `
+resource "datadog_dashboard_json" "time" {
+  type = "timeboard"
+
+  dashboard = <<EOF
+{
+  "title": "Acceptance Test JSON Timeboard",
+  "description": "Created using the Datadog provider in Terraform",
+  "read_only": true,
+  "graphs": [
+    {
+      "title": "Top System CPU by Docker container",
+      "definition": {
+        "viz": "toplist",
+        "requests": [
+          {"q": "top(avg:docker.cpu.system{*} by {container_name}, 10, 'mean', 'desc')"}
+        ],
+        "yaxis": {"min": 0.0, "max": 100.0}
+      }
+    }
+  ]
+}
+EOF
+}
+`
+
+const testAccCheckDatadogDashboardJSONTimeboardUpdatedConfig = `
+resource "datadog_dashboard_json" "time" {
+  type = "timeboard"
+
+  dashboard = <<EOF
+{
+  "title": "Acceptance Test JSON Timeboard updated",
+  "description": "Created using the Datadog provider in Terraform",
+  "read_only": true,
+  "graphs": [
+    {
+      "title": "Top System CPU by Docker container",
+      "definition": {
+        "viz": "toplist",
+        "requests": [
+          {"q": "top(avg:docker.cpu.system{*} by {container_name}, 10, 'mean', 'desc')"}
+        ],
+        "yaxis": {"min": 0, "max": 100}
+      }
+    }
+  ]
+}
+EOF
+}
+`
+
+const testAccCheckDatadogDashboardJSONScreenboardConfig = `
+resource "datadog_dashboard_json" "screen" {
+  type = "screenboard"
+
+  dashboard = <<EOF
+{
+  "board_title": "Acceptance Test JSON Screenboard",
+  "read_only": true,
+  "widgets": [
+    {
+      "type": "log_stream",
+      "query": "service:web status:error",
+      "logset": "main",
+      "x": 1, "y": 1, "width": 40, "height": 20
+    },
+    {
+      "type": "servicemap",
+      "service_service": "web",
+      "service_env": "prod",
+      "x": 42, "y": 1, "width": 40, "height": 20
+    }
+  ]
+}
+EOF
+}
+`
+
+func TestAccDatadogDashboardJSON_Timeboard(t *testing.T) {
+	var id string
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogDashboardJSONDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogDashboardJSONTimeboardConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDashboardJSONExists("datadog_dashboard_json.time"),
+					resource.TestCheckResourceAttr("datadog_dashboard_json.time", "type", "timeboard"),
+					testAccCheckDatadogDashboardJSONAttr("datadog_dashboard_json.time", "title", "Acceptance Test JSON Timeboard"),
+				),
+			},
+			{
+				Config: testAccCheckDatadogDashboardJSONTimeboardUpdatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDashboardJSONExists("datadog_dashboard_json.time"),
+					testAccCheckDatadogDashboardJSONAttr("datadog_dashboard_json.time", "title", "Acceptance Test JSON Timeboard updated"),
+					testAccSaveDashboardJSONID("datadog_dashboard_json.time", &id),
+				),
+			},
+			{
+				// Attributes added outside of Terraform show up in the plan.
+				PreConfig: testAccUpdateDashboardJSON(t, &id, "template_variables", []interface{}{
+					map[string]interface{}{"name": "host", "prefix": "host"},
+				}),
+				Config:             testAccCheckDatadogDashboardJSONTimeboardUpdatedConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDatadogDashboardJSON_Screenboard(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogDashboardJSONDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogDashboardJSONScreenboardConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDashboardJSONExists("datadog_dashboard_json.screen"),
+					resource.TestCheckResourceAttr("datadog_dashboard_json.screen", "type", "screenboard"),
+					testAccCheckDatadogDashboardJSONAttr("datadog_dashboard_json.screen", "board_title", "Acceptance Test JSON Screenboard"),
+					testAccCheckDatadogDashboardJSONWidgetTypes("datadog_dashboard_json.screen", "log_stream", "servicemap"),
+				),
+			},
+			{
+				ResourceName: "datadog_dashboard_json.screen",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "screenboard:" + s.RootModule().Resources["datadog_dashboard_json.screen"].Primary.ID, nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckDatadogDashboardJSONAttr checks a top-level attribute of the
+// dashboard as stored by the API.
+func testAccCheckDatadogDashboardJSONAttr(n, key, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		dashboard, err := testAccGetDashboardJSON(s, n)
+		if err != nil {
+			return err
+		}
+		if value := fmt.Sprint(dashboard[key]); value != expected {
+			return fmt.Errorf("dashboard %s is %q, expected %q", key, value, expected)
+		}
+		return nil
+	}
+}
+
+// testAccCheckDatadogDashboardJSONWidgetTypes checks that widgets unknown to
+// the datadog_screenboard resource made it to the API.
+func testAccCheckDatadogDashboardJSONWidgetTypes(n string, types ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		dashboard, err := testAccGetDashboardJSON(s, n)
+		if err != nil {
+			return err
+		}
+		widgets, _ := dashboard["widgets"].([]interface{})
+		if len(widgets) != len(types) {
+			return fmt.Errorf("dashboard has %d widgets, expected %d", len(widgets), len(types))
+		}
+		for i, w := range widgets {
+			if widgetType := w.(map[string]interface{})["type"]; widgetType != types[i] {
+				return fmt.Errorf("widget %d is a %v, expected a %s", i, widgetType, types[i])
+			}
+		}
+		return nil
+	}
+}
+
+// testAccSaveDashboardJSONID saves the ID of the dashboard for the next steps.
+func testAccSaveDashboardJSONID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		*id = s.RootModule().Resources[n].Primary.ID
+		return nil
+	}
+}
+
+// testAccUpdateDashboardJSON sets a top-level attribute of a timeboard as if it
+// was edited outside of Terraform.
+func testAccUpdateDashboardJSON(t *testing.T, id *string, key string, value interface{}) func() {
+	return func() {
+		client := testAccProvider.Meta().(*datadog.Client)
+		dashboard, err := getDashboardJSON(client, "timeboard", *id)
+		if err != nil {
+			t.Fatalf("Received an error retrieving dashboard %s", err)
+		}
+		dashboard[key] = value
+		if err := doDatadogRequest(client, "PUT", dashboardJSONEndpoint("timeboard", *id), nil, dashboard, nil); err != nil {
+			t.Fatalf("Received an error updating dashboard %s", err)
+		}
+	}
+}
+
+func testAccGetDashboardJSON(s *terraform.State, n string) (map[string]interface{}, error) {
+	client := testAccProvider.Meta().(*datadog.Client)
+	r := s.RootModule().Resources[n]
+	return getDashboardJSON(client, r.Primary.Attributes["type"], r.Primary.ID)
+}
+
+func testAccCheckDatadogDashboardJSONExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, err := testAccGetDashboardJSON(s, n); err != nil {
+			return fmt.Errorf("Received an error retrieving dashboard %s", err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogDashboardJSONDestroy(s *terraform.State) error {
+	for n, r := range s.RootModule().Resources {
+		if r.Type != "datadog_dashboard_json" {
+			continue
+		}
+		if _, err := testAccGetDashboardJSON(s, n); err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return fmt.Errorf("Received an error retrieving dashboard %s", err)
+		}
+		return fmt.Errorf("Dashboard still exists")
+	}
+	return nil
+}
+
+func TestNormalizeDashboardJSON(t *testing.T) {
+	cases := []struct {
+		in, out string
+	}{
+		{`{"title": "foo", "graphs": []}`, `{"graphs":[],"title":"foo"}`},
+		{`{"id": 123, "title": "foo", "created": "2019-03-05T00:00:00Z", "modified": "2019-03-05T00:00:00Z"}`, `{"title":"foo"}`},
+		{`{"dash": {"id": 123, "title": "foo"}}`, `{"title":"foo"}`},
+		{`{"title": "foo", "description": null}`, `{"title":"foo"}`},
+		{`{"yaxis": {"min": 0.0, "max": 100.5, "scale": 1E2}}`, `{"yaxis":{"max":100.5,"min":0,"scale":100}}`},
+		{`{"widgets": [{"x": 1.0, "alert_id": 7}]}`, `{"widgets":[{"alert_id":7,"x":1}]}`},
+		{`{"widgets": [{"id": 1, "type": "group", "widgets": [{"id": 2, "type": "note"}]}]}`, `{"widgets":[{"type":"group","widgets":[{"type":"note"}]}]}`},
+		{`{"widgets": [{"id": 1, "definition": {"type": "group", "widgets": [{"id": 2, "definition": {"type": "note"}}]}}]}`, `{"widgets":[{"definition":{"type":"group","widgets":[{"definition":{"type":"note"}}]}}]}`},
+		{`{"graphs": [{"id": 1, "title": "cpu", "definition": {"id": 3, "viz": "timeseries"}}]}`, `{"graphs":[{"definition":{"id":3,"viz":"timeseries"},"title":"cpu"}]}`},
+		{`{"widgets": [{"id": 1, "type": "alert_graph", "monitor": {"id": 42}}]}`, `{"widgets":[{"monitor":{"id":42},"type":"alert_graph"}]}`},
+		{``, ``},
+	}
+	for _, tc := range cases {
+		out, err := normalizeDashboardJSON(tc.in)
+		if err != nil {
+			t.Errorf("%s: %s", tc.in, err)
+			continue
+		}
+		if out != tc.out {
+			t.Errorf("%s: got %s, expected %s", tc.in, out, tc.out)
+		}
+	}
+}
+
+func TestDashboardTypeAndIDFromImportID(t *testing.T) {
+	cases := []struct {
+		importID, dashboardType, id string
+		err                         bool
+	}{
+		{"timeboard:123", "timeboard", "123", false},
+		{"screenboard:456", "screenboard", "456", false},
+		{"123", "", "", true},
+		{"timeboard:", "", "", true},
+		{"dashboard:123", "", "", true},
+		{"screenboard:abc", "", "", true},
+	}
+	for _, tc := range cases {
+		dashboardType, id, err := dashboardTypeAndIDFromImportID(tc.importID)
+		if (err != nil) != tc.err {
+			t.Errorf("%s: unexpected error %v", tc.importID, err)
+		}
+		if dashboardType != tc.dashboardType || id != tc.id {
+			t.Errorf("%s: got (%q, %q), expected (%q, %q)", tc.importID, dashboardType, id, tc.dashboardType, tc.id)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package datadog
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/zorkian/go-datadog-api"
+)
+
+func resourceDatadogDashboardList() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatadogDashboardListCreate,
+		Read:   resourceDatadogDashboardListRead,
+		Update: resourceDatadogDashboardListUpdate,
+		Delete: resourceDatadogDashboardListDelete,
+		Exists: resourceDatadogDashboardListExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dash_item": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A set of dashboards to add to the dashboard list.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								datadog.DashboardListItemCustomTimeboard,
+								datadog.DashboardListItemCustomScreenboard,
+								datadog.DashboardListItemIntegerationTimeboard,
+								datadog.DashboardListItemIntegrationScreenboard,
+								datadog.DashboardListItemHostTimeboard,
+							}, false),
+						},
+						"dash_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// buildDashboardListItems converts a set of dash_item blocks into API items.
+func buildDashboardListItems(items *schema.Set) ([]datadog.DashboardListItem, error) {
+	dashboards := []datadog.DashboardListItem{}
+	for _, iInterface := range items.List() {
+		i := iInterface.(map[string]interface{})
+
+		id, err := strconv.Atoi(i["dash_id"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("dash_id %q is not a valid dashboard ID: %s", i["dash_id"], err.Error())
+		}
+
+		item := datadog.DashboardListItem{}
+		item.SetId(id)
+		item.SetType(i["type"].(string))
+
+		dashboards = append(dashboards, item)
+	}
+	return dashboards, nil
+}
+
+func resourceDatadogDashboardListCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	list := &datadog.DashboardList{}
+	list.SetName(d.Get("name").(string))
+
+	dashboards, err := buildDashboardListItems(d.Get("dash_item").(*schema.Set))
+	if err != nil {
+		return err
+	}
+
+	list, err = client.CreateDashboardList(list)
+	if err != nil {
+		return fmt.Errorf("error creating dashboard list: %s", err.Error())
+	}
+	d.SetId(strconv.Itoa(list.GetId()))
+
+	if len(dashboards) > 0 {
+		if _, err := client.AddDashboardListItems(list.GetId(), dashboards); err != nil {
+			return fmt.Errorf("error adding dashboards to dashboard list: %s", err.Error())
+		}
+	}
+
+	return resourceDatadogDashboardListRead(d, meta)
+}
+
+func resourceDatadogDashboardListRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	list, err := client.GetDashboardList(id)
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] dashboard list %d not found, removing it from state", id)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	items, err := client.GetDashboardListItems(id)
+	if err != nil {
+		return err
+	}
+
+	dashItems := []map[string]interface{}{}
+	for _, item := range items {
+		dashItems = append(dashItems, map[string]interface{}{
+			"type":    item.GetType(),
+			"dash_id": strconv.Itoa(item.GetId()),
+		})
+	}
+
+	d.Set("name", list.GetName())
+	if err := d.Set("dash_item", dashItems); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceDatadogDashboardListUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("name") {
+		list := &datadog.DashboardList{}
+		list.SetId(id)
+		list.SetName(d.Get("name").(string))
+		if err := client.UpdateDashboardList(list); err != nil {
+			return fmt.Errorf("error updating dashboard list: %s", err.Error())
+		}
+	}
+
+	// Only send the membership changes so that dashboards which stay in the
+	// list are never removed, not even briefly.
+	if d.HasChange("dash_item") {
+		o, n := d.GetChange("dash_item")
+		oldItems, newItems := o.(*schema.Set), n.(*schema.Set)
+
+		removed, err := buildDashboardListItems(oldItems.Difference(newItems))
+		if err != nil {
+			return err
+		}
+		added, err := buildDashboardListItems(newItems.Difference(oldItems))
+		if err != nil {
+			return err
+		}
+
+		if len(removed) > 0 {
+			if _, err := client.DeleteDashboardListItems(id, removed); err != nil {
+				return fmt.Errorf("error removing dashboards from dashboard list: %s", err.Error())
+			}
+		}
+		if len(added) > 0 {
+			if _, err := client.AddDashboardListItems(id, added); err != nil {
+				return fmt.Errorf("error adding dashboards to dashboard list: %s", err.Error())
+			}
+		}
+	}
+
+	return resourceDatadogDashboardListRead(d, meta)
+}
+
+func resourceDatadogDashboardListDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteDashboardList(id); err != nil {
+		return fmt.Errorf("error deleting dashboard list: %s", err.Error())
+	}
+
+	return nil
+}
+
+func resourceDatadogDashboardListExists(d *schema.ResourceData, meta interface{}) (b bool, e error) {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return false, err
+	}
+	if _, err = meta.(*datadog.Client).GetDashboardList(id); err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
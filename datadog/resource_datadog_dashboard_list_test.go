@@ -0,0 +1,174 @@
+package datadog
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	datadog "github.com/zorkian/go-datadog-api"
+)
+
+func TestAccDatadogDashboardList_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogDashboardListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogDashboardListConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDashboardListExists("datadog_dashboard_list.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_dashboard_list.foo", "name", "foo list"),
+					resource.TestCheckResourceAttr(
+						"datadog_dashboard_list.foo", "dash_item.#", "2"),
+					testAccCheckDatadogDashboardListItemCount("datadog_dashboard_list.foo", 2),
+				),
+			},
+			{
+				Config: testAccCheckDatadogDashboardListConfigUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDashboardListExists("datadog_dashboard_list.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_dashboard_list.foo", "name", "foo list updated"),
+					resource.TestCheckResourceAttr(
+						"datadog_dashboard_list.foo", "dash_item.#", "1"),
+					testAccCheckDatadogDashboardListItemCount("datadog_dashboard_list.foo", 1),
+				),
+			},
+		},
+	})
+}
+
+func TestDatadogDashboardList_import(t *testing.T) {
+	resourceName := "datadog_dashboard_list.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogDashboardListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogDashboardListConfig,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogDashboardListExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*datadog.Client)
+		id, err := strconv.Atoi(s.RootModule().Resources[n].Primary.ID)
+		if err != nil {
+			return err
+		}
+		if _, err := client.GetDashboardList(id); err != nil {
+			return fmt.Errorf("Received an error retrieving dashboard list %s", err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogDashboardListItemCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*datadog.Client)
+		id, err := strconv.Atoi(s.RootModule().Resources[n].Primary.ID)
+		if err != nil {
+			return err
+		}
+		items, err := client.GetDashboardListItems(id)
+		if err != nil {
+			return fmt.Errorf("Received an error retrieving dashboard list items %s", err)
+		}
+		if len(items) != expected {
+			return fmt.Errorf("Dashboard list has %d dashboards, expected %d", len(items), expected)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogDashboardListDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*datadog.Client)
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "datadog_dashboard_list" {
+			continue
+		}
+		id, err := strconv.Atoi(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if _, err := client.GetDashboardList(id); err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return fmt.Errorf("Received an error retrieving dashboard list %s", err)
+		}
+		return fmt.Errorf("Dashboard list still exists")
+	}
+	return nil
+}
+
+const testAccCheckDatadogDashboardListDashboards = `
+resource "datadog_timeboard" "time" {
+  title       = "Dashboard list test timeboard"
+  description = "created using the Datadog provider in Terraform"
+  read_only   = true
+
+  graph {
+    title = "Top System CPU by Docker container"
+    viz   = "toplist"
+
+    request {
+      q = "top(avg:docker.cpu.system{*} by {container_name}, 10, 'mean', 'desc')"
+    }
+  }
+}
+
+resource "datadog_screenboard" "screen" {
+  title     = "Dashboard list test screenboard"
+  read_only = true
+  width     = "640"
+  height    = "480"
+
+  widget {
+    type = "free_text"
+    x    = 5
+    y    = 5
+    text = "test text"
+  }
+}
+`
+
+const testAccCheckDatadogDashboardListConfig = testAccCheckDatadogDashboardListDashboards + `
+resource "datadog_dashboard_list" "foo" {
+  name = "foo list"
+
+  dash_item {
+    type    = "custom_timeboard"
+    dash_id = "${datadog_timeboard.time.id}"
+  }
+
+  dash_item {
+    type    = "custom_screenboard"
+    dash_id = "${datadog_screenboard.screen.id}"
+  }
+}
+`
+
+const testAccCheckDatadogDashboardListConfigUpdated = testAccCheckDatadogDashboardListDashboards + `
+resource "datadog_dashboard_list" "foo" {
+  name = "foo list updated"
+
+  dash_item {
+    type    = "custom_screenboard"
+    dash_id = "${datadog_screenboard.screen.id}"
+  }
+}
+`
@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/hashicorp/terraform/helper/validation"
 	datadog "github.com/zorkian/go-datadog-api"
 )
 
@@ -22,6 +21,7 @@ func resourceDatadogDowntime() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceDatadogDowntimeImport,
 		},
+		CustomizeDiff: resourceDatadogDowntimeCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"active": {
@@ -46,7 +46,7 @@ func resourceDatadogDowntime() *schema.Resource {
 			},
 			"start_date": {
 				Type:          schema.TypeString,
-				ValidateFunc:  validation.ValidateRFC3339TimeString,
+				ValidateFunc:  validateDatadogDowntimeDate,
 				ConflictsWith: []string{"start"},
 				Optional:      true,
 			},
@@ -55,15 +55,33 @@ func resourceDatadogDowntime() *schema.Resource {
 				Optional: true,
 				DiffSuppressFunc: func(k, oldVal, newVal string, d *schema.ResourceData) bool {
 					_, endDatePresent := d.GetOk("end_date")
-					return endDatePresent
+					_, durationPresent := d.GetOk("duration")
+					return endDatePresent || durationPresent
 				},
 			},
 			"end_date": {
 				Type:          schema.TypeString,
-				ValidateFunc:  validation.ValidateRFC3339TimeString,
+				ValidateFunc:  validateDatadogDowntimeDate,
 				ConflictsWith: []string{"end"},
 				Optional:      true,
 			},
+			"duration": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"end", "end_date"},
+				ValidateFunc:  validateDatadogDowntimeDuration,
+				DiffSuppressFunc: func(k, oldVal, newVal string, d *schema.ResourceData) bool {
+					o, oErr := time.ParseDuration(oldVal)
+					n, nErr := time.ParseDuration(newVal)
+					return oErr == nil && nErr == nil && o == n
+				},
+			},
+			"timezone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: validateDatadogDowntimeTimezone,
+			},
 			"message": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -79,13 +97,21 @@ func resourceDatadogDowntime() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"period": {
 							Type:     schema.TypeInt,
-							Required: true,
+							Optional: true,
 						},
 						"type": {
 							Type:         schema.TypeString,
-							Required:     true,
+							Optional:     true,
 							ValidateFunc: validateDatadogDowntimeRecurrenceType,
 						},
+						"rrule": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateDatadogDowntimeRRule,
+							DiffSuppressFunc: func(k, oldVal, newVal string, d *schema.ResourceData) bool {
+								return downtimeRRulesEqual(oldVal, newVal)
+							},
+						},
 						"until_date": {
 							Type:          schema.TypeInt,
 							Optional:      true,
@@ -113,15 +139,50 @@ func resourceDatadogDowntime() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"monitor_id": {
-				Type:     schema.TypeInt,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"monitor_tags"},
+			},
+			"monitor_tags": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"monitor_id"},
+				Elem:          &schema.Schema{Type: schema.TypeString},
+			},
+			"recreate_on_expiry": {
+				Type:     schema.TypeBool,
 				Optional: true,
+				Default:  false,
 			},
 		},
 	}
 }
 
-func buildDowntimeStruct(d *schema.ResourceData) *datadog.Downtime {
-	var dt datadog.Downtime
+// downtimeLocalDateLayout is the layout of dates without a UTC offset, which
+// are wall-clock times in the time zone of the downtime.
+const downtimeLocalDateLayout = "2006-01-02T15:04:05"
+
+// parseDowntimeDate parses a RFC3339 date, or a wall-clock date in loc.
+func parseDowntimeDate(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation(downtimeLocalDateLayout, value, loc)
+}
+
+func downtimeLocation(d *schema.ResourceData) *time.Location {
+	loc, err := time.LoadLocation(d.Get("timezone").(string))
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+func buildDowntimeStruct(d *schema.ResourceData) *downtime {
+	var dt downtime
+
+	loc := downtimeLocation(d)
+	dt.Timezone = datadog.String(loc.String())
 
 	if attr, ok := d.GetOk("active"); ok {
 		dt.SetActive(attr.(bool))
@@ -129,10 +190,24 @@ func buildDowntimeStruct(d *schema.ResourceData) *datadog.Downtime {
 	if attr, ok := d.GetOk("disabled"); ok {
 		dt.SetDisabled(attr.(bool))
 	}
+	if attr, ok := d.GetOk("start_date"); ok {
+		if t, err := parseDowntimeDate(attr.(string), loc); err == nil {
+			dt.SetStart(int(t.Unix()))
+		}
+	} else if attr, ok := d.GetOk("start"); ok {
+		dt.SetStart(attr.(int))
+	}
 	if attr, ok := d.GetOk("end_date"); ok {
-		if t, err := time.Parse(time.RFC3339, attr.(string)); err == nil {
+		if t, err := parseDowntimeDate(attr.(string), loc); err == nil {
 			dt.SetEnd(int(t.Unix()))
 		}
+	} else if attr, ok := d.GetOk("duration"); ok {
+		// The end read back from the API is in the state too, the duration
+		// wins since they conflict. CustomizeDiff makes sure there's a start.
+		start, hasStart := dt.GetStartOk()
+		if duration, err := time.ParseDuration(attr.(string)); err == nil && hasStart {
+			dt.SetEnd(int(time.Unix(int64(start), 0).Add(duration).Unix()))
+		}
 	} else if attr, ok := d.GetOk("end"); ok {
 		dt.SetEnd(attr.(int))
 	}
@@ -143,9 +218,21 @@ func buildDowntimeStruct(d *schema.ResourceData) *datadog.Downtime {
 	if attr, ok := d.GetOk("monitor_id"); ok {
 		dt.SetMonitorId(attr.(int))
 	}
+	if attr, ok := d.GetOk("monitor_tags"); ok {
+		for _, tag := range attr.([]interface{}) {
+			dt.MonitorTags = append(dt.MonitorTags, tag.(string))
+		}
+	} else if d.HasChange("monitor_tags") && dt.MonitorId == nil {
+		// Removed tags have to be reset to their default explicitly.
+		dt.MonitorTags = []string{"*"}
+	}
 	if _, ok := d.GetOk("recurrence"); ok {
-		var recurrence datadog.Recurrence
+		var recurrence downtimeRecurrence
 
+		if attr, ok := d.GetOk("recurrence.0.rrule"); ok {
+			recurrence.SetType("rrule")
+			recurrence.RRule = datadog.String(attr.(string))
+		}
 		if attr, ok := d.GetOk("recurrence.0.period"); ok {
 			recurrence.SetPeriod(attr.(int))
 		}
@@ -166,22 +253,50 @@ func buildDowntimeStruct(d *schema.ResourceData) *datadog.Downtime {
 			recurrence.WeekDays = weekDays
 		}
 
-		dt.SetRecurrence(recurrence)
+		dt.Recurrence = &recurrence
 	}
 	scope := []string{}
 	for _, s := range d.Get("scope").([]interface{}) {
 		scope = append(scope, s.(string))
 	}
 	dt.Scope = scope
-	if attr, ok := d.GetOk("start_date"); ok {
-		if t, err := time.Parse(time.RFC3339, attr.(string)); err == nil {
-			dt.SetStart(int(t.Unix()))
+
+	return &dt
+}
+
+// resourceDatadogDowntimeCustomizeDiff checks that a duration has a start to
+// count from, and that a recurrence is either a rule or a type and a period.
+func resourceDatadogDowntimeCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// Downtimes without a start start when they're created, a duration
+	// counted from now would move their end on every update.
+	if _, ok := diff.GetOk("duration"); ok && diff.NewValueKnown("start") && diff.NewValueKnown("start_date") {
+		_, hasStart := diff.GetOk("start")
+		_, hasStartDate := diff.GetOk("start_date")
+		if !hasStart && !hasStartDate {
+			return fmt.Errorf("duration needs a start or a start_date to count from")
 		}
-	} else if attr, ok := d.GetOk("start"); ok {
-		dt.SetStart(attr.(int))
 	}
 
-	return &dt
+	if _, ok := diff.GetOk("recurrence"); !ok {
+		return nil
+	}
+
+	if _, ok := diff.GetOk("recurrence.0.rrule"); ok {
+		for _, k := range []string{"type", "period", "week_days", "until_date", "until_occurrences"} {
+			if _, ok := diff.GetOk("recurrence.0." + k); ok {
+				return fmt.Errorf("recurrence.0.%s can't be used with recurrence.0.rrule, use the rule instead", k)
+			}
+		}
+		return nil
+	}
+
+	if _, ok := diff.GetOk("recurrence.0.type"); !ok {
+		return fmt.Errorf("recurrence needs either a type and a period, or a rrule")
+	}
+	if _, ok := diff.GetOk("recurrence.0.period"); !ok {
+		return fmt.Errorf("recurrence.0.period is required with recurrence.0.type")
+	}
+	return nil
 }
 
 func resourceDatadogDowntimeExists(d *schema.ResourceData, meta interface{}) (b bool, e error) {
@@ -195,7 +310,7 @@ func resourceDatadogDowntimeExists(d *schema.ResourceData, meta interface{}) (b
 	}
 
 	if _, err = client.GetDowntime(id); err != nil {
-		if strings.Contains(err.Error(), "404 Not Found") {
+		if isNotFoundError(err) {
 			return false, nil
 		}
 		return false, err
@@ -208,14 +323,14 @@ func resourceDatadogDowntimeCreate(d *schema.ResourceData, meta interface{}) err
 	client := meta.(*datadog.Client)
 
 	dts := buildDowntimeStruct(d)
-	dt, err := client.CreateDowntime(dts)
+	dt, err := createDowntime(client, dts)
 	if err != nil {
 		return fmt.Errorf("error updating downtime: %s", err.Error())
 	}
 
 	d.SetId(strconv.Itoa(dt.GetId()))
 
-	return nil
+	return resourceDatadogDowntimeRead(d, meta)
 }
 
 func resourceDatadogDowntimeRead(d *schema.ResourceData, meta interface{}) error {
@@ -226,13 +341,57 @@ func resourceDatadogDowntimeRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	dt, err := client.GetDowntime(id)
+	dt, err := getDowntime(client, id)
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] downtime %d not found, removing it from state", id)
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
 
 	log.Printf("[DEBUG] downtime: %v", dt)
 
+	// Read runs right after Create too, when even a downtime which is already
+	// over has to be stored.
+	if downtimeIsOver(dt) && !d.IsNewResource() {
+		// A new downtime for a window that is over would be over right away,
+		// and created again on every apply.
+		if d.Get("recreate_on_expiry").(bool) && !downtimeWindowIsPast(d) {
+			log.Printf("[WARN] downtime %d has expired or was canceled, removing it from state so that it is created again", id)
+			d.SetId("")
+			return nil
+		}
+		// Datadog won't update it anymore, keep it as it was so that configs
+		// with past windows plan cleanly. Update replaces it if the config
+		// changes.
+		log.Printf("[INFO] downtime %d has expired or was canceled, keeping its last known state", id)
+		return nil
+	}
+
+	return updateDowntimeState(d, dt)
+}
+
+// downtimeIsOver reports whether a downtime was canceled, or is a one-shot
+// downtime whose end is past.
+func downtimeIsOver(dt *downtime) bool {
+	if dt.GetCanceled() > 0 {
+		return true
+	}
+	end, hasEnd := dt.GetEndOk()
+	return dt.Recurrence == nil && hasEnd && end > 0 && int64(end) <= time.Now().Unix()
+}
+
+// downtimeWindowIsPast reports whether the window set by d is a one-shot
+// window whose end is past.
+func downtimeWindowIsPast(d *schema.ResourceData) bool {
+	dt := buildDowntimeStruct(d)
+	end, hasEnd := dt.GetEndOk()
+	return dt.Recurrence == nil && hasEnd && end > 0 && int64(end) <= time.Now().Unix()
+}
+
+func updateDowntimeState(d *schema.ResourceData, dt *downtime) error {
 	if err := d.Set("active", dt.GetActive()); err != nil {
 		return err
 	}
@@ -248,37 +407,67 @@ func resourceDatadogDowntimeRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("monitor_id", dt.GetMonitorId()); err != nil {
 		return err
 	}
+	// ["*"] is the default, it's only kept when it's in the config.
+	monitorTags := dt.MonitorTags
+	if len(monitorTags) == 1 && monitorTags[0] == "*" {
+		if tags, ok := d.GetOk("monitor_tags"); !ok || len(tags.([]interface{})) != 1 || tags.([]interface{})[0] != "*" {
+			monitorTags = nil
+		}
+	}
+	if err := d.Set("monitor_tags", monitorTags); err != nil {
+		return err
+	}
+
+	if r := dt.Recurrence; r != nil {
+		d.Set("recurrence", []map[string]interface{}{flattenDowntimeRecurrence(r)})
+	}
+	d.Set("scope", dt.Scope)
+	d.Set("start", dt.GetStart())
+	d.Set("timezone", dt.GetTimezone())
+	// The API only knows the end, the duration is read back only when it's
+	// used instead of end or end_date.
+	if attr, ok := d.GetOk("duration"); ok {
+		duration := time.Duration(dt.GetEnd()-dt.GetStart()) * time.Second
+		if configured, err := time.ParseDuration(attr.(string)); err != nil || configured != duration {
+			d.Set("duration", duration.String())
+		}
+	}
+
+	return nil
+}
 
-	if r, ok := dt.GetRecurrenceOk(); ok {
-		recurrence := make(map[string]interface{})
-		recurrenceList := make([]map[string]interface{}, 0, 1)
+// flattenDowntimeRecurrence returns a recurrence the way it's configured.
+func flattenDowntimeRecurrence(r *downtimeRecurrence) map[string]interface{} {
+	recurrence := make(map[string]interface{})
 
+	if r.GetType() == "rrule" {
+		// The period and type of rules aren't part of the configuration.
+		if r.RRule != nil {
+			recurrence["rrule"] = *r.RRule
+		}
+	} else {
 		if attr, ok := r.GetPeriodOk(); ok {
 			recurrence["period"] = strconv.Itoa(attr)
 		}
 		if attr, ok := r.GetTypeOk(); ok {
 			recurrence["type"] = attr
 		}
-		if attr, ok := r.GetUntilDateOk(); ok {
-			recurrence["until_date"] = strconv.Itoa(attr)
-		}
-		if attr, ok := r.GetUntilOccurrencesOk(); ok {
-			recurrence["until_occurrences"] = strconv.Itoa(attr)
-		}
-		if r.WeekDays != nil {
-			weekDays := make([]string, 0, len(r.WeekDays))
-			for _, weekDay := range r.WeekDays {
-				weekDays = append(weekDays, weekDay)
-			}
-			recurrence["week_days"] = weekDays
+	}
+	if attr, ok := r.GetUntilDateOk(); ok {
+		recurrence["until_date"] = strconv.Itoa(attr)
+	}
+	if attr, ok := r.GetUntilOccurrencesOk(); ok {
+		recurrence["until_occurrences"] = strconv.Itoa(attr)
+	}
+	if r.WeekDays != nil {
+		weekDays := make([]string, 0, len(r.WeekDays))
+		for _, weekDay := range r.WeekDays {
+			weekDays = append(weekDays, weekDay)
 		}
-		recurrenceList = append(recurrenceList, recurrence)
-		d.Set("recurrence", recurrenceList)
+		recurrence["week_days"] = weekDays
 	}
-	d.Set("scope", dt.Scope)
-	d.Set("start", dt.GetStart())
 
-	return nil
+	return recurrence
 }
 
 func resourceDatadogDowntimeUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -289,9 +478,25 @@ func resourceDatadogDowntimeUpdate(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		return err
 	}
-	dt.SetId(id)
 
-	if err = client.UpdateDowntime(dt); err != nil {
+	// Datadog refuses to update downtimes which are over, a new one is
+	// needed for the new window.
+	current, err := getDowntime(client, id)
+	if err != nil {
+		return fmt.Errorf("error updating downtime: %s", err.Error())
+	}
+	if downtimeIsOver(current) {
+		log.Printf("[INFO] downtime %d has expired or was canceled, creating a new one", id)
+		created, err := createDowntime(client, dt)
+		if err != nil {
+			return fmt.Errorf("error updating downtime: %s", err.Error())
+		}
+		d.SetId(strconv.Itoa(created.GetId()))
+		return updateDowntimeState(d, created)
+	}
+
+	dt.SetId(id)
+	if err = updateDowntime(client, dt); err != nil {
 		return fmt.Errorf("error updating downtime: %s", err.Error())
 	}
 
@@ -307,6 +512,9 @@ func resourceDatadogDowntimeDelete(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if err = client.DeleteDowntime(id); err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
 		return err
 	}
 
@@ -314,12 +522,54 @@ func resourceDatadogDowntimeDelete(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceDatadogDowntimeImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	if err := resourceDatadogDowntimeRead(d, meta); err != nil {
+	client := meta.(*datadog.Client)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	// Downtimes which are over are imported too, Read would skip them.
+	dt, err := getDowntime(client, id)
+	if err != nil {
+		return nil, err
+	}
+	d.Set("recreate_on_expiry", false)
+	if err := updateDowntimeState(d, dt); err != nil {
 		return nil, err
 	}
 	return []*schema.ResourceData{d}, nil
 }
 
+func validateDatadogDowntimeDate(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := parseDowntimeDate(value, time.UTC); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a RFC3339 date, e.g. 2019-10-31T11:11:00+01:00, or a date without offset in the time zone of the downtime, e.g. 2019-10-31T11:11:00, got %q", k, value))
+	}
+	return
+}
+
+func validateDatadogDowntimeDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if duration, err := time.ParseDuration(value); err != nil || duration <= 0 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a positive duration, e.g. 90m or 2h, got %q", k, value))
+	}
+	return
+}
+
+func validateDatadogDowntimeTimezone(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	// LoadLocation also accepts "" and "Local", which aren't time zones the
+	// API knows.
+	if _, err := time.LoadLocation(value); err != nil || value == "" || value == "Local" {
+		errors = append(errors, fmt.Errorf(
+			"%q must be an IANA time zone, e.g. UTC or Europe/Paris, got %q", k, value))
+	}
+	return
+}
+
 func validateDatadogDowntimeRecurrenceType(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	switch value {
@@ -26,9 +26,9 @@ func TestAccDatadogDowntime_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "scope.0", "*"),
 					resource.TestCheckResourceAttr(
-						"datadog_downtime.foo", "start", "1735707600"),
+						"datadog_downtime.foo", "start", "4070840400"),
 					resource.TestCheckResourceAttr(
-						"datadog_downtime.foo", "end", "1735765200"),
+						"datadog_downtime.foo", "end", "4070898000"),
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "recurrence.0.type", "days"),
 					resource.TestCheckResourceAttr(
@@ -77,9 +77,9 @@ func TestAccDatadogDowntime_BasicMultiScope(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "scope.1", "host:B"),
 					resource.TestCheckResourceAttr(
-						"datadog_downtime.foo", "start", "1735707600"),
+						"datadog_downtime.foo", "start", "4070840400"),
 					resource.TestCheckResourceAttr(
-						"datadog_downtime.foo", "end", "1735765200"),
+						"datadog_downtime.foo", "end", "4070898000"),
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "recurrence.0.type", "days"),
 					resource.TestCheckResourceAttr(
@@ -105,9 +105,9 @@ func TestAccDatadogDowntime_BasicNoRecurrence(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "scope.0", "host:NoRecurrence"),
 					resource.TestCheckResourceAttr(
-						"datadog_downtime.foo", "start", "1735707600"),
+						"datadog_downtime.foo", "start", "4070840400"),
 					resource.TestCheckResourceAttr(
-						"datadog_downtime.foo", "end", "1735765200"),
+						"datadog_downtime.foo", "end", "4070898000"),
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "message", "Example Datadog downtime message."),
 				),
@@ -129,15 +129,15 @@ func TestAccDatadogDowntime_BasicUntilDateRecurrence(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "scope.0", "host:UntilDateRecurrence"),
 					resource.TestCheckResourceAttr(
-						"datadog_downtime.foo", "start", "1735707600"),
+						"datadog_downtime.foo", "start", "4070840400"),
 					resource.TestCheckResourceAttr(
-						"datadog_downtime.foo", "end", "1735765200"),
+						"datadog_downtime.foo", "end", "4070898000"),
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "recurrence.0.type", "days"),
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "recurrence.0.period", "1"),
 					resource.TestCheckResourceAttr(
-						"datadog_downtime.foo", "recurrence.0.until_date", "1736226000"),
+						"datadog_downtime.foo", "recurrence.0.until_date", "4071358800"),
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "message", "Example Datadog downtime message."),
 				),
@@ -159,9 +159,9 @@ func TestAccDatadogDowntime_BasicUntilOccurrencesRecurrence(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "scope.0", "host:UntilOccurrencesRecurrence"),
 					resource.TestCheckResourceAttr(
-						"datadog_downtime.foo", "start", "1735707600"),
+						"datadog_downtime.foo", "start", "4070840400"),
 					resource.TestCheckResourceAttr(
-						"datadog_downtime.foo", "end", "1735765200"),
+						"datadog_downtime.foo", "end", "4070898000"),
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "recurrence.0.type", "days"),
 					resource.TestCheckResourceAttr(
@@ -189,9 +189,9 @@ func TestAccDatadogDowntime_WeekDayRecurring(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "scope.0", "WeekDaysRecurrence"),
 					resource.TestCheckResourceAttr(
-						"datadog_downtime.foo", "start", "1735646400"),
+						"datadog_downtime.foo", "start", "4070779200"),
 					resource.TestCheckResourceAttr(
-						"datadog_downtime.foo", "end", "1735732799"),
+						"datadog_downtime.foo", "end", "4070865599"),
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "recurrence.0.type", "weeks"),
 					resource.TestCheckResourceAttr(
@@ -221,9 +221,9 @@ func TestAccDatadogDowntime_Updated(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "scope.0", "*"),
 					resource.TestCheckResourceAttr(
-						"datadog_downtime.foo", "start", "1735707600"),
+						"datadog_downtime.foo", "start", "4070840400"),
 					resource.TestCheckResourceAttr(
-						"datadog_downtime.foo", "end", "1735765200"),
+						"datadog_downtime.foo", "end", "4070898000"),
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "recurrence.0.type", "days"),
 					resource.TestCheckResourceAttr(
@@ -239,9 +239,9 @@ func TestAccDatadogDowntime_Updated(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "scope.0", "Updated"),
 					resource.TestCheckResourceAttr(
-						"datadog_downtime.foo", "start", "1735707600"),
+						"datadog_downtime.foo", "start", "4070840400"),
 					resource.TestCheckResourceAttr(
-						"datadog_downtime.foo", "end", "1735765200"),
+						"datadog_downtime.foo", "end", "4070898000"),
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "recurrence.0.type", "days"),
 					resource.TestCheckResourceAttr(
@@ -267,9 +267,9 @@ func TestAccDatadogDowntime_TrimWhitespace(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "scope.0", "host:Whitespace"),
 					resource.TestCheckResourceAttr(
-						"datadog_downtime.foo", "start", "1735707600"),
+						"datadog_downtime.foo", "start", "4070840400"),
 					resource.TestCheckResourceAttr(
-						"datadog_downtime.foo", "end", "1735765200"),
+						"datadog_downtime.foo", "end", "4070898000"),
 					resource.TestCheckResourceAttr(
 						"datadog_downtime.foo", "recurrence.0.type", "days"),
 					resource.TestCheckResourceAttr(
@@ -347,6 +347,513 @@ func TestAccDatadogDowntimeDatesConflict(t *testing.T) {
 	})
 }
 
+func TestAccDatadogDowntime_Timezone(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogDowntimeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDatadogDowntimeConfigDurationConflict,
+				ExpectError: regexp.MustCompile("\"duration\": conflicts with end"),
+			},
+			{
+				Config:      testAccCheckDatadogDowntimeConfigDurationNoStart,
+				ExpectError: regexp.MustCompile("duration needs a start or a start_date"),
+			},
+			{
+				Config: testAccCheckDatadogDowntimeConfigTimezone,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeExists("datadog_downtime.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "timezone", "Europe/Paris"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "start_date", "2099-11-03T02:00:00"),
+					// 02:00 in Paris is 01:00 UTC in November.
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "start", "4097350800"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "end", "4097358000"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "duration", "2h"),
+				),
+			},
+			{
+				Config: testAccCheckDatadogDowntimeConfigTimezoneUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeExists("datadog_downtime.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "end", "4097356200"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "duration", "1h30m0s"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDatadogDowntimeConfigDurationConflict = `
+resource "datadog_downtime" "foo" {
+  scope    = ["*"]
+  start    = 4097350800
+  end      = 4097358000
+  duration = "2h"
+}
+`
+
+const testAccCheckDatadogDowntimeConfigDurationNoStart = `
+resource "datadog_downtime" "foo" {
+  scope    = ["*"]
+  duration = "2h"
+}
+`
+
+const testAccCheckDatadogDowntimeConfigTimezone = `
+resource "datadog_downtime" "foo" {
+  scope      = ["host:Timezone"]
+  timezone   = "Europe/Paris"
+  start_date = "2099-11-03T02:00:00"
+  duration   = "2h"
+
+  recurrence {
+    type      = "weeks"
+    period    = 1
+    week_days = ["Tue"]
+  }
+
+  message = "Example Datadog downtime message."
+}
+`
+
+const testAccCheckDatadogDowntimeConfigTimezoneUpdated = `
+resource "datadog_downtime" "foo" {
+  scope      = ["host:Timezone"]
+  timezone   = "Europe/Paris"
+  start_date = "2099-11-03T02:00:00"
+  duration   = "1h30m0s"
+
+  recurrence {
+    type      = "weeks"
+    period    = 1
+    week_days = ["Tue"]
+  }
+
+  message = "Example Datadog downtime message."
+}
+`
+
+func TestParseDowntimeDate(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		value    string
+		expected string
+	}{
+		// Dates with an offset don't depend on the time zone.
+		{"2026-03-24T02:00:00+01:00", "2026-03-24T01:00:00Z"},
+		{"2026-03-24T02:00:00Z", "2026-03-24T02:00:00Z"},
+		// Wall-clock dates keep their local time across DST changes.
+		{"2026-03-24T02:00:00", "2026-03-24T01:00:00Z"},
+		{"2026-03-31T02:00:00", "2026-03-31T00:00:00Z"},
+		{"2026-10-27T02:00:00", "2026-10-27T01:00:00Z"},
+	}
+
+	for _, tc := range cases {
+		d, err := parseDowntimeDate(tc.value, paris)
+		if err != nil {
+			t.Fatalf("parsing %q failed: %s", tc.value, err)
+		}
+		if out := d.UTC().Format(time.RFC3339); out != tc.expected {
+			t.Fatalf("parsing %q returned %s, expected %s", tc.value, out, tc.expected)
+		}
+	}
+}
+
+func TestResourceDatadogDowntimeTimeValidation(t *testing.T) {
+	cases := []struct {
+		Validate func(interface{}, string) ([]string, []error)
+		Value    string
+		ErrCount int
+	}{
+		{validateDatadogDowntimeDate, "2099-10-31T11:11:00+01:00", 0},
+		{validateDatadogDowntimeDate, "2099-10-31T11:11:00", 0},
+		{validateDatadogDowntimeDate, "2099-10-31", 1},
+		{validateDatadogDowntimeDate, "2099-10-31 11:11", 1},
+		{validateDatadogDowntimeDuration, "2h", 0},
+		{validateDatadogDowntimeDuration, "1h30m", 0},
+		{validateDatadogDowntimeDuration, "0s", 1},
+		{validateDatadogDowntimeDuration, "2 hours", 1},
+		{validateDatadogDowntimeTimezone, "UTC", 0},
+		{validateDatadogDowntimeTimezone, "Europe/Paris", 0},
+		{validateDatadogDowntimeTimezone, "Europe/Nowhere", 1},
+		{validateDatadogDowntimeTimezone, "Local", 1},
+		{validateDatadogDowntimeTimezone, "", 1},
+	}
+
+	for _, tc := range cases {
+		_, errors := tc.Validate(tc.Value, "datadog_downtime_time")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected Datadog Downtime validation to trigger %d error(s) for value %q - instead saw %d",
+				tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestAccDatadogDowntime_RRule(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogDowntimeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDatadogDowntimeConfigRRule("FREQ=HOURLY", ""),
+				ExpectError: regexp.MustCompile("invalid recurrence rule"),
+			},
+			{
+				Config:      testAccCheckDatadogDowntimeConfigRRule("FREQ=DAILY", "period = 1"),
+				ExpectError: regexp.MustCompile("recurrence.0.period can't be used with recurrence.0.rrule"),
+			},
+			{
+				Config:      testAccCheckDatadogDowntimeConfigRRule("", "period = 1"),
+				ExpectError: regexp.MustCompile("recurrence needs either a type and a period, or a rrule"),
+			},
+			{
+				// The first Monday of each month.
+				Config: testAccCheckDatadogDowntimeConfigRRule("RRULE:freq=monthly;byday=mo;bysetpos=1", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeExists("datadog_downtime.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "recurrence.0.rrule", "RRULE:FREQ=MONTHLY;BYDAY=MO;BYSETPOS=1"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "recurrence.0.type", ""),
+				),
+			},
+			{
+				// The last business day of each month.
+				Config: testAccCheckDatadogDowntimeConfigRRule("FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeExists("datadog_downtime.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "recurrence.0.rrule", "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1"),
+				),
+			},
+			{
+				Config: testAccCheckDatadogDowntimeConfigRRule("", "type = \"days\"\n    period = 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeExists("datadog_downtime.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "recurrence.0.type", "days"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "recurrence.0.rrule", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogDowntimeConfigRRule(rrule, recurrence string) string {
+	if rrule != "" {
+		recurrence = fmt.Sprintf("rrule = %q\n    %s", rrule, recurrence)
+	}
+	return fmt.Sprintf(`
+resource "datadog_downtime" "foo" {
+  scope    = ["host:RRule"]
+  start    = 4097350800
+  duration = "2h"
+
+  recurrence {
+    %s
+  }
+
+  message = "Example Datadog downtime message."
+}
+`, recurrence)
+}
+
+func TestAccDatadogDowntime_Canceled(t *testing.T) {
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogDowntimeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogDowntimeConfigOver("Canceled", "Example Datadog downtime message.", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeExists("datadog_downtime.foo"),
+					testAccCheckDatadogDowntimeID("datadog_downtime.foo", &id, false),
+				),
+			},
+			{
+				// A canceled downtime plans cleanly.
+				PreConfig: testAccCancelDatadogDowntime(t, &id),
+				Config:    testAccCheckDatadogDowntimeConfigOver("Canceled", "Example Datadog downtime message.", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeID("datadog_downtime.foo", &id, false),
+				),
+			},
+			{
+				// Changing it creates a new one.
+				Config: testAccCheckDatadogDowntimeConfigOver("Canceled", "Updated Datadog downtime message.", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeID("datadog_downtime.foo", &id, true),
+					testAccCheckDatadogDowntimeNotCanceled("datadog_downtime.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "message", "Updated Datadog downtime message."),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatadogDowntime_RecreateOnExpiry(t *testing.T) {
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogDowntimeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogDowntimeConfigOver("RecreateOnExpiry", "Example Datadog downtime message.", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeExists("datadog_downtime.foo"),
+					testAccCheckDatadogDowntimeID("datadog_downtime.foo", &id, false),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "recreate_on_expiry", "true"),
+				),
+			},
+			{
+				PreConfig: testAccCancelDatadogDowntime(t, &id),
+				Config:    testAccCheckDatadogDowntimeConfigOver("RecreateOnExpiry", "Example Datadog downtime message.", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeID("datadog_downtime.foo", &id, true),
+					testAccCheckDatadogDowntimeNotCanceled("datadog_downtime.foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatadogDowntime_RecreateOnExpiryPastWindow(t *testing.T) {
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogDowntimeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogDowntimeConfigPastWindow,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeExists("datadog_downtime.foo"),
+					testAccCheckDatadogDowntimeID("datadog_downtime.foo", &id, false),
+				),
+			},
+			{
+				// A downtime whose configured window is over isn't created again.
+				Config:   testAccCheckDatadogDowntimeConfigPastWindow,
+				PlanOnly: true,
+			},
+			{
+				Config: testAccCheckDatadogDowntimeConfigPastWindow,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeID("datadog_downtime.foo", &id, false),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDatadogDowntimeConfigPastWindow = `
+resource "datadog_downtime" "foo" {
+  scope   = ["host:RecreateOnExpiryPastWindow"]
+  start   = 1577836800
+  end     = 1577840400
+  message = "Example Datadog downtime message."
+
+  recreate_on_expiry = true
+}
+`
+
+func testAccCheckDatadogDowntimeConfigOver(scope, message string, recreate bool) string {
+	return fmt.Sprintf(`
+resource "datadog_downtime" "foo" {
+  scope   = ["host:%s"]
+  start   = 4070840400
+  end     = 4070898000
+  message = "%s"
+
+  recreate_on_expiry = %t
+}
+`, scope, message, recreate)
+}
+
+// testAccCheckDatadogDowntimeID stores the ID of a downtime in id, after
+// checking whether it changed since it was last stored.
+func testAccCheckDatadogDowntimeID(n string, id *string, changed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		current := s.RootModule().Resources[n].Primary.ID
+		if *id != "" && (current != *id) != changed {
+			return fmt.Errorf("the ID of downtime %s went from %s to %s, expected it to change: %t", n, *id, current, changed)
+		}
+		*id = current
+		return nil
+	}
+}
+
+// testAccCancelDatadogDowntime cancels a downtime outside of Terraform.
+func testAccCancelDatadogDowntime(t *testing.T, id *string) func() {
+	return func() {
+		client := testAccProvider.Meta().(*datadog.Client)
+		i, _ := strconv.Atoi(*id)
+		if err := client.DeleteDowntime(i); err != nil {
+			t.Fatalf("Received an error canceling downtime %s", err)
+		}
+	}
+}
+
+func testAccCheckDatadogDowntimeNotCanceled(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*datadog.Client)
+		id, _ := strconv.Atoi(s.RootModule().Resources[n].Primary.ID)
+		dt, err := client.GetDowntime(id)
+		if err != nil {
+			return fmt.Errorf("Received an error retrieving downtime %s", err)
+		}
+		if dt.GetCanceled() > 0 {
+			return fmt.Errorf("Downtime %d is canceled", id)
+		}
+		return nil
+	}
+}
+
+func TestDowntimeIsOver(t *testing.T) {
+	now := int(time.Now().Unix())
+	recurrence := &downtimeRecurrence{}
+	recurrence.SetType("days")
+	recurrence.SetPeriod(1)
+
+	cases := []struct {
+		name       string
+		canceled   int
+		end        int
+		recurrence *downtimeRecurrence
+		over       bool
+	}{
+		{"future", 0, now + 3600, nil, false},
+		{"no end", 0, 0, nil, false},
+		{"expired", 0, now - 3600, nil, true},
+		{"canceled", now - 60, now + 3600, nil, true},
+		{"recurring", 0, now - 3600, recurrence, false},
+		{"canceled recurring", now - 60, now - 3600, recurrence, true},
+	}
+
+	for _, tc := range cases {
+		var dt downtime
+		if tc.canceled != 0 {
+			dt.SetCanceled(tc.canceled)
+		}
+		if tc.end != 0 {
+			dt.SetEnd(tc.end)
+		}
+		dt.Recurrence = tc.recurrence
+		if over := downtimeIsOver(&dt); over != tc.over {
+			t.Fatalf("%s downtime: over is %t, expected %t", tc.name, over, tc.over)
+		}
+	}
+}
+
+func TestAccDatadogDowntime_MonitorTags(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogDowntimeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDatadogDowntimeConfigMonitorTagsConflict,
+				ExpectError: regexp.MustCompile("\"monitor_tags\": conflicts with monitor_id"),
+			},
+			{
+				Config: testAccCheckDatadogDowntimeConfigMonitorTags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeExists("datadog_downtime.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "monitor_tags.#", "2"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "monitor_tags.0", "team:payments"),
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "monitor_tags.1", "env:prod"),
+					testAccCheckDatadogDowntimeMonitorTags("datadog_downtime.foo", "team:payments", "env:prod"),
+				),
+			},
+			{
+				ResourceName:      "datadog_downtime.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Removing the tags silences every monitor of the scope again.
+				Config: testAccCheckDatadogDowntimeConfigMonitorTagsRemoved,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"datadog_downtime.foo", "monitor_tags.#", "0"),
+					testAccCheckDatadogDowntimeMonitorTags("datadog_downtime.foo", "*"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogDowntimeMonitorTags(n string, expected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*datadog.Client)
+		id, _ := strconv.Atoi(s.RootModule().Resources[n].Primary.ID)
+		dt, err := getDowntime(client, id)
+		if err != nil {
+			return fmt.Errorf("Received an error retrieving downtime %s", err)
+		}
+		if fmt.Sprint(dt.MonitorTags) != fmt.Sprint(expected) {
+			return fmt.Errorf("the monitor tags of downtime %d are %v, expected %v", id, dt.MonitorTags, expected)
+		}
+		return nil
+	}
+}
+
+const testAccCheckDatadogDowntimeConfigMonitorTagsConflict = `
+resource "datadog_downtime" "foo" {
+  scope        = ["*"]
+  start        = 4070840400
+  end          = 4070898000
+  monitor_id   = 12345
+  monitor_tags = ["team:payments"]
+}
+`
+
+const testAccCheckDatadogDowntimeConfigMonitorTags = `
+resource "datadog_downtime" "foo" {
+  scope        = ["*"]
+  start        = 4070840400
+  end          = 4070898000
+  monitor_tags = ["team:payments", "env:prod"]
+  message      = "Payments release."
+}
+`
+
+const testAccCheckDatadogDowntimeConfigMonitorTagsRemoved = `
+resource "datadog_downtime" "foo" {
+  scope   = ["*"]
+  start   = 4070840400
+  end     = 4070898000
+  message = "Payments release."
+}
+`
+
 const testAccCheckDatadogDowntimeConfigDates = `
 resource "datadog_downtime" "foo" {
   scope = ["*"]
@@ -366,9 +873,9 @@ const testAccCheckDatadogDowntimeConfigDatesConflict = `
 resource "datadog_downtime" "foo" {
   scope = ["*"]
   start_date = "2099-10-31T11:11:00+01:00"
-  start = 1735707600
+  start = 4070840400
   end_date = "2099-10-31T11:11:00+01:00"
-  end = 1735707600
+  end = 4070840400
 
   recurrence {
     type   = "days"
@@ -382,8 +889,8 @@ resource "datadog_downtime" "foo" {
 const testAccCheckDatadogDowntimeConfig = `
 resource "datadog_downtime" "foo" {
   scope = ["*"]
-  start = 1735707600
-  end   = 1735765200
+  start = 4070840400
+  end   = 4070898000
 
   recurrence {
     type   = "days"
@@ -429,8 +936,8 @@ resource "datadog_downtime" "foo" {
 const testAccCheckDatadogDowntimeConfigMultiScope = `
 resource "datadog_downtime" "foo" {
   scope = ["host:A", "host:B"]
-  start = 1735707600
-  end   = 1735765200
+  start = 4070840400
+  end   = 4070898000
 
   recurrence {
     type   = "days"
@@ -444,8 +951,8 @@ resource "datadog_downtime" "foo" {
 const testAccCheckDatadogDowntimeConfigNoRecurrence = `
 resource "datadog_downtime" "foo" {
   scope = ["host:NoRecurrence"]
-  start = 1735707600
-  end   = 1735765200
+  start = 4070840400
+  end   = 4070898000
   message = "Example Datadog downtime message."
 }
 `
@@ -453,13 +960,13 @@ resource "datadog_downtime" "foo" {
 const testAccCheckDatadogDowntimeConfigUntilDateRecurrence = `
 resource "datadog_downtime" "foo" {
   scope = ["host:UntilDateRecurrence"]
-  start = 1735707600
-  end   = 1735765200
+  start = 4070840400
+  end   = 4070898000
 
   recurrence {
     type       = "days"
     period     = 1
-	until_date = 1736226000
+	until_date = 4071358800
   }
 
   message = "Example Datadog downtime message."
@@ -469,8 +976,8 @@ resource "datadog_downtime" "foo" {
 const testAccCheckDatadogDowntimeConfigUntilOccurrencesRecurrence = `
 resource "datadog_downtime" "foo" {
   scope = ["host:UntilOccurrencesRecurrence"]
-  start = 1735707600
-  end   = 1735765200
+  start = 4070840400
+  end   = 4070898000
 
   recurrence {
     type              = "days"
@@ -485,8 +992,8 @@ resource "datadog_downtime" "foo" {
 const testAccCheckDatadogDowntimeConfigWeekDaysRecurrence = `
 resource "datadog_downtime" "foo" {
   scope = ["WeekDaysRecurrence"]
-  start = 1735646400
-  end   = 1735732799
+  start = 4070779200
+  end   = 4070865599
 
   recurrence {
     period    = 1
@@ -501,8 +1008,8 @@ resource "datadog_downtime" "foo" {
 const testAccCheckDatadogDowntimeConfigUpdated = `
 resource "datadog_downtime" "foo" {
   scope = ["Updated"]
-  start = 1735707600
-  end   = 1735765200
+  start = 4070840400
+  end   = 4070898000
 
   recurrence {
     type   = "days"
@@ -516,8 +1023,8 @@ resource "datadog_downtime" "foo" {
 const testAccCheckDatadogDowntimeConfigWhitespace = `
 resource "datadog_downtime" "foo" {
   scope = ["host:Whitespace"]
-  start = 1735707600
-  end   = 1735765200
+  start = 4070840400
+  end   = 4070898000
 
   recurrence {
     type   = "days"
@@ -0,0 +1,188 @@
+package datadog
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/zorkian/go-datadog-api"
+)
+
+// Mutes all the monitors of a host. The host name is the resource ID.
+func resourceDatadogHostMute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatadogHostMuteCreate,
+		Read:   resourceDatadogHostMuteRead,
+		Update: resourceDatadogHostMuteUpdate,
+		Delete: resourceDatadogHostMuteDelete,
+		Exists: resourceDatadogHostMuteExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceDatadogHostMuteImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"host": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Optional: true,
+				StateFunc: func(val interface{}) string {
+					return strings.TrimSpace(val.(string))
+				},
+			},
+			"end": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"end_date"},
+			},
+			"end_date": {
+				Type:          schema.TypeString,
+				ValidateFunc:  validation.ValidateRFC3339TimeString,
+				ConflictsWith: []string{"end"},
+				Optional:      true,
+			},
+			"override": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func buildHostMuteStruct(d *schema.ResourceData, override bool) *datadog.HostActionMute {
+	var mute datadog.HostActionMute
+
+	if attr, ok := d.GetOk("message"); ok {
+		mute.Message = datadog.String(strings.TrimSpace(attr.(string)))
+	}
+	if attr, ok := d.GetOk("end_date"); ok {
+		if t, err := time.Parse(time.RFC3339, attr.(string)); err == nil {
+			mute.EndTime = datadog.String(strconv.FormatInt(t.Unix(), 10))
+		}
+	} else if attr, ok := d.GetOk("end"); ok {
+		mute.EndTime = datadog.String(strconv.Itoa(attr.(int)))
+	}
+	mute.Override = datadog.Bool(override)
+
+	return &mute
+}
+
+func resourceDatadogHostMuteCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	host := d.Get("host").(string)
+	if _, err := client.MuteHost(host, buildHostMuteStruct(d, d.Get("override").(bool))); err != nil {
+		return fmt.Errorf("error muting host %s: %s", host, err.Error())
+	}
+
+	d.SetId(host)
+
+	return resourceDatadogHostMuteRead(d, meta)
+}
+
+func resourceDatadogHostMuteRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	status, err := getHostMuteStatus(client, d.Id())
+	if err != nil {
+		return err
+	}
+	if status == nil {
+		log.Printf("[WARN] host %s not found, removing its mute from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	// Datadog unmutes hosts once the end of their mute is past, a mute that
+	// expired or was removed outside of Terraform has to be created again.
+	if !status.IsMuted || (status.MuteTimeout != nil && int64(*status.MuteTimeout) <= time.Now().Unix()) {
+		log.Printf("[WARN] host %s is not muted anymore, removing its mute from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	end := 0
+	if status.MuteTimeout != nil {
+		end = *status.MuteTimeout
+	}
+
+	// The message isn't returned, the one in the state is kept. The end is
+	// read back into whichever of end and end_date is used, so that removing
+	// end_date doesn't leave a stale end behind.
+	d.Set("host", status.Name)
+	if attr, ok := d.GetOk("end_date"); ok {
+		if t, err := time.Parse(time.RFC3339, attr.(string)); err != nil || int(t.Unix()) != end {
+			if end == 0 {
+				d.Set("end_date", "")
+			} else {
+				d.Set("end_date", time.Unix(int64(end), 0).UTC().Format(time.RFC3339))
+			}
+		}
+	} else {
+		d.Set("end", end)
+	}
+
+	return nil
+}
+
+func resourceDatadogHostMuteUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	// Muting a muted host again replaces its mute.
+	if _, err := client.MuteHost(d.Id(), buildHostMuteStruct(d, true)); err != nil {
+		return fmt.Errorf("error updating mute of host %s: %s", d.Id(), err.Error())
+	}
+
+	return resourceDatadogHostMuteRead(d, meta)
+}
+
+func resourceDatadogHostMuteDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	// Unmuting a host whose mute has expired fails.
+	status, err := getHostMuteStatus(client, d.Id())
+	if err != nil {
+		return err
+	}
+	if status == nil || !status.IsMuted || (status.MuteTimeout != nil && int64(*status.MuteTimeout) <= time.Now().Unix()) {
+		return nil
+	}
+
+	if _, err := client.UnmuteHost(d.Id()); err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("error unmuting host %s: %s", d.Id(), err.Error())
+	}
+
+	return nil
+}
+
+func resourceDatadogHostMuteExists(d *schema.ResourceData, meta interface{}) (b bool, e error) {
+	client := meta.(*datadog.Client)
+
+	status, err := getHostMuteStatus(client, d.Id())
+	if err != nil {
+		return false, err
+	}
+	return status != nil, nil
+}
+
+func resourceDatadogHostMuteImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	host := d.Id()
+	d.Set("override", false)
+	if err := resourceDatadogHostMuteRead(d, meta); err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("host %s is not muted", host)
+	}
+	return []*schema.ResourceData{d}, nil
+}
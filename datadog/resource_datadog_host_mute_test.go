@@ -0,0 +1,216 @@
+package datadog
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/zorkian/go-datadog-api"
+)
+
+func TestAccDatadogHostMute_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogHostMuteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDatadogHostMuteConfigPastEnd,
+				ExpectError: regexp.MustCompile("it must be a timestamp in the future"),
+			},
+			{
+				Config: testAccCheckDatadogHostMuteConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogHostMuteExists("datadog_host_mute.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_host_mute.foo", "host", "tf-host-mute.example.org"),
+					resource.TestCheckResourceAttr(
+						"datadog_host_mute.foo", "message", "Decommissioning"),
+					resource.TestCheckResourceAttr(
+						"datadog_host_mute.foo", "end_date", "2030-01-01T00:00:00Z"),
+					resource.TestCheckNoResourceAttr(
+						"datadog_host_mute.foo", "end"),
+				),
+			},
+			{
+				// Removing end_date makes the mute last until it's destroyed.
+				Config: testAccCheckDatadogHostMuteConfigUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogHostMuteExists("datadog_host_mute.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_host_mute.foo", "message", "Decommissioning, for good"),
+					resource.TestCheckResourceAttr(
+						"datadog_host_mute.foo", "end", "0"),
+				),
+			},
+			{
+				// A mute which ended outside of Terraform is created again.
+				PreConfig: func() {
+					client := testAccProvider.Meta().(*datadog.Client)
+					if _, err := client.UnmuteHost("tf-host-mute.example.org"); err != nil {
+						t.Fatalf("Received an error unmuting host %s", err)
+					}
+				},
+				Config: testAccCheckDatadogHostMuteConfigUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogHostMuteExists("datadog_host_mute.foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatadogHostMute_Expired(t *testing.T) {
+	end := time.Now().Unix() + 2
+	config := fmt.Sprintf(testAccCheckDatadogHostMuteConfigExpiring, end)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogHostMuteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogHostMuteExists("datadog_host_mute.foo"),
+				),
+			},
+			{
+				// An expired mute shows up as drift.
+				PreConfig: func() {
+					time.Sleep(time.Until(time.Unix(end+1, 0)))
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDatadogHostMute_ManyHosts(t *testing.T) {
+	if testAccFakeAPI == nil {
+		t.Skip("needs an organization with more hosts matching the name than a page of the host search holds")
+	}
+	// The hosts whose names contain the muted host's come first in the
+	// results.
+	testAccFakeAPI.Lock()
+	for i := 0; i < 1200; i++ {
+		name := fmt.Sprintf("a%04d.tf-host-mute-paged.example.org", i)
+		testAccFakeAPI.hosts[name] = map[string]interface{}{"name": name, "is_muted": false, "mute_timeout": nil}
+	}
+	testAccFakeAPI.Unlock()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogHostMuteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogHostMuteConfigPaged,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogHostMuteExists("datadog_host_mute.foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestDatadogHostMute_import(t *testing.T) {
+	resourceName := "datadog_host_mute.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogHostMuteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogHostMuteConfigImport,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API doesn't return the message.
+				ImportStateVerifyIgnore: []string{"message"},
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogHostMuteExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*datadog.Client)
+		status, err := getHostMuteStatus(client, s.RootModule().Resources[n].Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Received an error retrieving host %s", err)
+		}
+		if status == nil || !status.IsMuted {
+			return fmt.Errorf("Host %s is not muted", s.RootModule().Resources[n].Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogHostMuteDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*datadog.Client)
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "datadog_host_mute" {
+			continue
+		}
+		status, err := getHostMuteStatus(client, r.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Received an error retrieving host %s", err)
+		}
+		if status != nil && status.IsMuted {
+			return fmt.Errorf("Host %s is still muted", r.Primary.ID)
+		}
+	}
+	return nil
+}
+
+const testAccCheckDatadogHostMuteConfigPastEnd = `
+resource "datadog_host_mute" "foo" {
+  host     = "tf-host-mute.example.org"
+  end_date = "2020-01-01T00:00:00Z"
+}
+`
+
+const testAccCheckDatadogHostMuteConfig = `
+resource "datadog_host_mute" "foo" {
+  host     = "tf-host-mute.example.org"
+  message  = "Decommissioning"
+  end_date = "2030-01-01T00:00:00Z"
+}
+`
+
+const testAccCheckDatadogHostMuteConfigUpdated = `
+resource "datadog_host_mute" "foo" {
+  host    = "tf-host-mute.example.org"
+  message = "Decommissioning, for good"
+}
+`
+
+const testAccCheckDatadogHostMuteConfigExpiring = `
+resource "datadog_host_mute" "foo" {
+  host = "tf-host-mute-expiring.example.org"
+  end  = %d
+}
+`
+
+const testAccCheckDatadogHostMuteConfigPaged = `
+resource "datadog_host_mute" "foo" {
+  host = "tf-host-mute-paged.example.org"
+}
+`
+
+const testAccCheckDatadogHostMuteConfigImport = `
+resource "datadog_host_mute" "foo" {
+  host    = "tf-host-mute-import.example.org"
+  message = "Decommissioning"
+  end     = 1893456000
+}
+`
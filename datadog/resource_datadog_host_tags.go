@@ -0,0 +1,231 @@
+package datadog
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/zorkian/go-datadog-api"
+)
+
+// hostAndSourceFromID splits a host tags ID. Sources never contain colons,
+// host names might.
+func hostAndSourceFromID(id string) (string, string, error) {
+	i := strings.LastIndex(id, ":")
+	if i <= 0 || i == len(id)-1 {
+		return "", "", fmt.Errorf("error extracting host and source from a host tags id: %s", id)
+	}
+	return id[:i], id[i+1:], nil
+}
+
+// The tags of a host for a single source. Only the tags declared in the
+// configuration are managed, tags added to the same host and source by
+// something else are left alone.
+func resourceDatadogHostTags() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatadogHostTagsCreate,
+		Read:   resourceDatadogHostTagsRead,
+		Update: resourceDatadogHostTagsUpdate,
+		Delete: resourceDatadogHostTagsDelete,
+		Exists: resourceDatadogHostTagsExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceDatadogHostTagsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"host": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "users",
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// mergeHostTags returns current without the removed tags and with the added
+// ones, sorted.
+func mergeHostTags(current, removed, added []string) []string {
+	tags := map[string]bool{}
+	for _, t := range current {
+		tags[t] = true
+	}
+	for _, t := range removed {
+		delete(tags, t)
+	}
+	for _, t := range added {
+		tags[t] = true
+	}
+	out := []string{}
+	for t := range tags {
+		out = append(out, t)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func setToStrings(s *schema.Set) []string {
+	out := []string{}
+	for _, v := range s.List() {
+		out = append(out, v.(string))
+	}
+	sort.Strings(out)
+	return out
+}
+
+func resourceDatadogHostTagsCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	host := d.Get("host").(string)
+	source := d.Get("source").(string)
+	// Adding merges with the tags already on the host.
+	if err := client.AddTagsToHost(host, source, setToStrings(d.Get("tags").(*schema.Set))); err != nil {
+		return fmt.Errorf("error adding tags to host %s: %s", host, err.Error())
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", host, source))
+
+	return resourceDatadogHostTagsRead(d, meta)
+}
+
+func resourceDatadogHostTagsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	host, source, err := hostAndSourceFromID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	current, err := client.GetHostTags(host, source)
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] host %s not found, removing its tags from state", host)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// Keep only the tags we own, so that a tag removed outside of Terraform
+	// shows up as a diff while tags added by others don't.
+	owned := d.Get("tags").(*schema.Set)
+	tags := []string{}
+	for _, t := range current {
+		if owned.Contains(t) {
+			tags = append(tags, t)
+		}
+	}
+
+	d.Set("host", host)
+	d.Set("source", source)
+	d.Set("tags", tags)
+
+	return nil
+}
+
+func resourceDatadogHostTagsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	host, source, err := hostAndSourceFromID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// The API can only replace all the tags of a source, merge our changes
+	// into the current ones.
+	current, err := client.GetHostTags(host, source)
+	if err != nil {
+		return fmt.Errorf("error getting tags of host %s: %s", host, err.Error())
+	}
+	o, n := d.GetChange("tags")
+	removed := setToStrings(o.(*schema.Set).Difference(n.(*schema.Set)))
+	added := setToStrings(n.(*schema.Set))
+	if err := client.UpdateHostTags(host, source, mergeHostTags(current, removed, added)); err != nil {
+		return fmt.Errorf("error updating tags of host %s: %s", host, err.Error())
+	}
+
+	return resourceDatadogHostTagsRead(d, meta)
+}
+
+func resourceDatadogHostTagsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	host, source, err := hostAndSourceFromID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	current, err := client.GetHostTags(host, source)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("error getting tags of host %s: %s", host, err.Error())
+	}
+
+	remaining := mergeHostTags(current, setToStrings(d.Get("tags").(*schema.Set)), nil)
+	if len(remaining) == 0 {
+		err = client.RemoveHostTags(host, source)
+	} else {
+		err = client.UpdateHostTags(host, source, remaining)
+	}
+	if err != nil {
+		return fmt.Errorf("error removing tags from host %s: %s", host, err.Error())
+	}
+
+	return nil
+}
+
+func resourceDatadogHostTagsExists(d *schema.ResourceData, meta interface{}) (b bool, e error) {
+	client := meta.(*datadog.Client)
+
+	host, source, err := hostAndSourceFromID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := client.GetHostTags(host, source); err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// The import ID is <host>:<source>, the source can't be left out as host
+// names might contain colons too. Every tag of the source is imported.
+func resourceDatadogHostTagsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*datadog.Client)
+
+	host, source, err := hostAndSourceFromID(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf(`error importing host tags from %q: expected "<host>:<source>", e.g. "%s:users"`, d.Id(), d.Id())
+	}
+
+	tags, err := client.GetHostTags(host, source)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tags of host %s: %s", host, err.Error())
+	}
+	if len(tags) == 0 {
+		return nil, fmt.Errorf("host %s has no %s tags to import", host, source)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", host, source))
+	d.Set("tags", tags)
+
+	return []*schema.ResourceData{d}, nil
+}
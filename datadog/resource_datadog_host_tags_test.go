@@ -0,0 +1,187 @@
+package datadog
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/zorkian/go-datadog-api"
+)
+
+func TestAccDatadogHostTags_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogHostTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogHostTagsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"datadog_host_tags.foo", "id", "tf-host-tags.example.org:users"),
+					resource.TestCheckResourceAttr(
+						"datadog_host_tags.foo", "source", "users"),
+					resource.TestCheckResourceAttr(
+						"datadog_host_tags.foo", "tags.#", "2"),
+					resource.TestCheckResourceAttr(
+						"datadog_host_tags.bar", "tags.#", "1"),
+					testAccCheckDatadogHostTags("tf-host-tags.example.org", "users", "env:prod", "owner:ops", "role:database"),
+				),
+			},
+			{
+				// Changing the tags of foo doesn't touch the tags of bar.
+				Config: testAccCheckDatadogHostTagsConfigUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"datadog_host_tags.foo", "tags.#", "2"),
+					testAccCheckDatadogHostTags("tf-host-tags.example.org", "users", "env:prod", "owner:ops", "team:storage"),
+				),
+			},
+			{
+				// Removing foo only removes its own tags.
+				Config: testAccCheckDatadogHostTagsConfigBarOnly,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"datadog_host_tags.bar", "tags.#", "1"),
+					testAccCheckDatadogHostTags("tf-host-tags.example.org", "users", "owner:ops"),
+				),
+			},
+		},
+	})
+}
+
+func TestDatadogHostTags_import(t *testing.T) {
+	resourceName := "datadog_host_tags.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogHostTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogHostTagsConfigImport,
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "tf-host-tags-import.example.org",
+				ExpectError:   regexp.MustCompile(`expected "<host>:<source>"`),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "tf-host-tags-import.example.org:users",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckDatadogHostTags checks all the tags of a host for a source.
+func testAccCheckDatadogHostTags(host, source string, expected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*datadog.Client)
+		tags, err := client.GetHostTags(host, source)
+		if err != nil {
+			return fmt.Errorf("Received an error retrieving host tags %s", err)
+		}
+		sort.Strings(tags)
+		sort.Strings(expected)
+		if fmt.Sprint(tags) != fmt.Sprint(expected) {
+			return fmt.Errorf("the %s tags of %s are %v, expected %v", source, host, tags, expected)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogHostTagsDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*datadog.Client)
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "datadog_host_tags" {
+			continue
+		}
+		host, source, err := hostAndSourceFromID(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+		tags, err := client.GetHostTags(host, source)
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return fmt.Errorf("Received an error retrieving host tags %s", err)
+		}
+		if len(tags) > 0 {
+			return fmt.Errorf("Host %s still has %s tags %v", host, source, tags)
+		}
+	}
+	return nil
+}
+
+const testAccCheckDatadogHostTagsConfig = `
+resource "datadog_host_tags" "foo" {
+  host = "tf-host-tags.example.org"
+  tags = ["env:prod", "role:database"]
+}
+
+resource "datadog_host_tags" "bar" {
+  # The API replaces all the tags of a source at once, changes to the same host
+  # and source must not run concurrently.
+  depends_on = ["datadog_host_tags.foo"]
+
+  host   = "tf-host-tags.example.org"
+  source = "users"
+  tags   = ["owner:ops"]
+}
+`
+
+const testAccCheckDatadogHostTagsConfigUpdated = `
+resource "datadog_host_tags" "foo" {
+  host = "tf-host-tags.example.org"
+  tags = ["env:prod", "team:storage"]
+}
+
+resource "datadog_host_tags" "bar" {
+  depends_on = ["datadog_host_tags.foo"]
+
+  host   = "tf-host-tags.example.org"
+  source = "users"
+  tags   = ["owner:ops"]
+}
+`
+
+const testAccCheckDatadogHostTagsConfigBarOnly = `
+resource "datadog_host_tags" "bar" {
+  host   = "tf-host-tags.example.org"
+  source = "users"
+  tags   = ["owner:ops"]
+}
+`
+
+const testAccCheckDatadogHostTagsConfigImport = `
+resource "datadog_host_tags" "foo" {
+  host = "tf-host-tags-import.example.org"
+  tags = ["env:staging", "role:cache"]
+}
+`
+
+func TestMergeHostTags(t *testing.T) {
+	cases := []struct {
+		current, removed, added, expected []string
+	}{
+		{[]string{"a", "b"}, nil, []string{"c"}, []string{"a", "b", "c"}},
+		{[]string{"a", "b"}, []string{"a"}, nil, []string{"b"}},
+		{[]string{"b", "a"}, []string{"a", "x"}, []string{"b", "c"}, []string{"b", "c"}},
+		{[]string{"a"}, []string{"a"}, nil, []string{}},
+		{nil, nil, []string{"a"}, []string{"a"}},
+	}
+
+	for _, tc := range cases {
+		if out := mergeHostTags(tc.current, tc.removed, tc.added); fmt.Sprint(out) != fmt.Sprint(tc.expected) {
+			t.Fatalf("merging %v - %v + %v returned %v, expected %v", tc.current, tc.removed, tc.added, out, tc.expected)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package datadog
 
 import (
 	"fmt"
+	"log"
+	"net/url"
 	"os"
 	"strings"
 
@@ -32,29 +34,26 @@ func resourceDatadogIntegrationAws() *schema.Resource {
 			"account_id": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true, // a different AWS account needs a new external_id
 			},
 			"role_name": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true, // waits for update API call support
 			},
 			"filter_tags": {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
-				ForceNew: true, // waits for update API call support
 			},
 			"host_tags": {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
-				ForceNew: true, // waits for update API call support
 			},
 			"account_specific_namespace_rules": {
 				Type:     schema.TypeMap,
 				Optional: true,
 				Elem:     schema.TypeBool,
-				ForceNew: true, // waits for update API call support
 			},
 			"external_id": {
 				Type:     schema.TypeString,
@@ -164,24 +163,23 @@ func resourceDatadogIntegrationAwsRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 	}
-	return fmt.Errorf("error getting a Amazon Web Services integration: account_id=%s, role_name=%s", accountID, roleName)
+	log.Printf("[WARN] Amazon Web Services integration account_id=%s, role_name=%s not found, removing it from state", accountID, roleName)
+	d.SetId("")
+	return nil
 }
 
-func resourceDatadogIntegrationAwsUpdate(d *schema.ResourceData, meta interface{}) error {
-	// Unfortunately the PUT operation for updating the AWS configuration is not available at the moment.
-	// However this feature is one we have in our backlog. I don't know if it's scheduled for delivery short-term,
-	// however I will follow-up after reviewing with product management.
-	// ©
-
-	// UpdateIntegrationAWS function:
-	// func (client *Client) UpdateIntegrationAWS(awsAccount *IntegrationAWSAccount) (*IntegrationAWSAccountCreateResponse, error) {
-	// 	var out IntegrationAWSAccountCreateResponse
-	// 	if err := client.doJsonRequest("PUT", "/v1/integration/aws", awsAccount, &out); err != nil {
-	// 		return nil, err
-	// 	}
-	// 	return &out, nil
-	// }
+// updateIntegrationAWS updates an AWS account of the AWS integration in place,
+// which keeps its external ID. go-datadog-api has no call for it yet. The
+// account is looked up by the account ID and role name it is currently
+// configured with, awsAccount holds the new values.
+func updateIntegrationAWS(client *datadog.Client, accountID, roleName string, awsAccount *datadog.IntegrationAWSAccount) error {
+	query := url.Values{}
+	query.Set("account_id", accountID)
+	query.Set("role_name", roleName)
+	return doDatadogRequest(client, "PUT", "/v1/integration/aws", query, awsAccount, nil)
+}
 
+func resourceDatadogIntegrationAwsUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*datadog.Client)
 
 	accountID, roleName, err := accountAndRoleFromID(d.Id())
@@ -189,13 +187,15 @@ func resourceDatadogIntegrationAwsUpdate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	iaws := resourceDatadogIntegrationAwsPrepareCreateRequest(d, accountID, roleName)
+	newRoleName := d.Get("role_name").(string)
+	iaws := resourceDatadogIntegrationAwsPrepareCreateRequest(d, accountID, newRoleName)
 
-	_, err = client.CreateIntegrationAWS(&iaws)
-	if err != nil {
+	if err := updateIntegrationAWS(client, accountID, roleName, &iaws); err != nil {
 		return fmt.Errorf("error updating a Amazon Web Services integration: %s", err.Error())
 	}
 
+	d.SetId(fmt.Sprintf("%s:%s", accountID, newRoleName))
+
 	return resourceDatadogIntegrationAwsRead(d, meta)
 }
 
@@ -3,6 +3,10 @@ package datadog
 import (
 	"fmt"
 	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	datadog "github.com/zorkian/go-datadog-api"
 )
 
 func TestAccountAndRoleFromID(t *testing.T) {
@@ -35,3 +39,133 @@ func TestAccountAndRoleFromID(t *testing.T) {
 		}
 	}
 }
+
+const testAccCheckDatadogIntegrationAWSConfig = `
+resource "datadog_integration_aws" "account" {
+  account_id = "1234567888"
+  role_name  = "testacc-datadog-integration-role"
+
+  filter_tags = ["key:value"]
+  host_tags   = ["key:value", "key2:value2"]
+
+  account_specific_namespace_rules {
+    auto_scaling = false
+    opsworks     = true
+  }
+}
+`
+
+const testAccCheckDatadogIntegrationAWSUpdatedConfig = `
+resource "datadog_integration_aws" "account" {
+  account_id = "1234567888"
+  role_name  = "testacc-datadog-integration-role-updated"
+
+  filter_tags = ["key:value", "other:value"]
+  host_tags   = ["key:value"]
+
+  account_specific_namespace_rules {
+    auto_scaling = true
+    opsworks     = true
+  }
+}
+`
+
+func TestAccDatadogIntegrationAWS(t *testing.T) {
+	var externalID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: checkIntegrationAWSDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogIntegrationAWSConfig,
+				Check: resource.ComposeTestCheckFunc(
+					checkIntegrationAWSExists,
+					resource.TestCheckResourceAttr(
+						"datadog_integration_aws.account", "id", "1234567888:testacc-datadog-integration-role"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_aws.account", "filter_tags.#", "1"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_aws.account", "host_tags.#", "2"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_aws.account", "account_specific_namespace_rules.auto_scaling", "false"),
+					resource.TestCheckResourceAttrSet(
+						"datadog_integration_aws.account", "external_id"),
+					func(s *terraform.State) error {
+						externalID = s.RootModule().Resources["datadog_integration_aws.account"].Primary.Attributes["external_id"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCheckDatadogIntegrationAWSUpdatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					checkIntegrationAWSExists,
+					resource.TestCheckResourceAttr(
+						"datadog_integration_aws.account", "id", "1234567888:testacc-datadog-integration-role-updated"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_aws.account", "role_name", "testacc-datadog-integration-role-updated"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_aws.account", "filter_tags.#", "2"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_aws.account", "host_tags.#", "1"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_aws.account", "account_specific_namespace_rules.auto_scaling", "true"),
+					func(s *terraform.State) error {
+						// The account must have been updated in place, not recreated.
+						updated := s.RootModule().Resources["datadog_integration_aws.account"].Primary.Attributes["external_id"]
+						if updated != externalID {
+							return fmt.Errorf("external_id changed from %q to %q", externalID, updated)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func checkIntegrationAWSExists(s *terraform.State) error {
+	client := testAccProvider.Meta().(*datadog.Client)
+	integrations, err := client.GetIntegrationAWS()
+	if err != nil {
+		return err
+	}
+	for _, r := range s.RootModule().Resources {
+		accountID, roleName, err := accountAndRoleFromID(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+		found := false
+		for _, integration := range *integrations {
+			if integration.GetAccountID() == accountID && integration.GetRoleName() == roleName {
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("The AWS integration for %s doesn't exist", r.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func checkIntegrationAWSDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*datadog.Client)
+	integrations, err := client.GetIntegrationAWS()
+	if err != nil {
+		return err
+	}
+	for _, r := range s.RootModule().Resources {
+		accountID, roleName, err := accountAndRoleFromID(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+		for _, integration := range *integrations {
+			if integration.GetAccountID() == accountID && integration.GetRoleName() == roleName {
+				return fmt.Errorf("The AWS integration for %s still exists", r.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package datadog
 
 import (
 	"fmt"
+	"log"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/zorkian/go-datadog-api"
@@ -119,7 +120,9 @@ func resourceDatadogIntegrationGcpRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 	}
-	return fmt.Errorf("error getting a Google Cloud Platform integration: project_id=%s", projectID)
+	log.Printf("[WARN] Google Cloud Platform integration project_id=%s not found, removing it from state", projectID)
+	d.SetId("")
+	return nil
 }
 
 func resourceDatadogIntegrationGcpUpdate(d *schema.ResourceData, meta interface{}) error {
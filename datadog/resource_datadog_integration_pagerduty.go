@@ -2,6 +2,7 @@ package datadog
 
 import (
 	"fmt"
+	"log"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/zorkian/go-datadog-api"
@@ -14,14 +15,22 @@ func resourceDatadogIntegrationPagerduty() *schema.Resource {
 		Update: resourceDatadogIntegrationPagerdutyUpdate,
 		Delete: resourceDatadogIntegrationPagerdutyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceDatadogIntegrationPagerdutyImport,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"individual_services": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				Description:   "Whether services are managed with datadog_integration_pagerduty_service_object resources instead of the services attribute.",
+				ConflictsWith: []string{"services"},
+			},
 			"services": {
-				Type:        schema.TypeList,
-				Required:    true,
-				Description: "A list of service names and service keys.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				Description:   "A list of service names and service keys.",
+				ConflictsWith: []string{"individual_services"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"service_name": {
@@ -65,6 +74,11 @@ func buildIntegrationPagerduty(d *schema.ResourceData) (*datadog.IntegrationPDRe
 	}
 	pd.Schedules = schedules
 
+	// Leaving the services out of the request keeps the existing ones.
+	if d.Get("individual_services").(bool) {
+		return pd, nil
+	}
+
 	services := []datadog.ServicePDRequest{}
 	for _, sInterface := range d.Get("services").([]interface{}) {
 		s := sInterface.(map[string]interface{})
@@ -107,18 +121,24 @@ func resourceDatadogIntegrationPagerdutyRead(d *schema.ResourceData, meta interf
 
 	pd, err := client.GetIntegrationPD()
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] pagerduty integration not found, removing it from state")
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
 
-	services := []map[string]string{}
-	for _, service := range pd.Services {
-		services = append(services, map[string]string{
-			"service_name": service.GetServiceName(),
-			"service_key":  service.GetServiceKey(),
-		})
+	if !d.Get("individual_services").(bool) {
+		services := []map[string]string{}
+		for _, service := range pd.Services {
+			services = append(services, map[string]string{
+				"service_name": service.GetServiceName(),
+				"service_key":  service.GetServiceKey(),
+			})
+		}
+		d.Set("services", services)
 	}
-
-	d.Set("services", services)
 	d.Set("subdomain", pd.GetSubdomain())
 	d.Set("schedules", pd.Schedules)
 	d.Set("api_token", pd.GetAPIToken())
@@ -150,3 +170,10 @@ func resourceDatadogIntegrationPagerdutyDelete(d *schema.ResourceData, meta inte
 
 	return nil
 }
+
+// Imported integrations manage their services with the services attribute,
+// set individual_services to true after the import to use service objects.
+func resourceDatadogIntegrationPagerdutyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("individual_services", false)
+	return []*schema.ResourceData{d}, nil
+}
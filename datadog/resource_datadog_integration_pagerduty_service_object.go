@@ -0,0 +1,104 @@
+package datadog
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/zorkian/go-datadog-api"
+)
+
+// A single service of the PagerDuty integration, so that services can be owned
+// by different configurations. The service name is the resource ID.
+func resourceDatadogIntegrationPagerdutyServiceObject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatadogIntegrationPagerdutyServiceObjectCreate,
+		Read:   resourceDatadogIntegrationPagerdutyServiceObjectRead,
+		Update: resourceDatadogIntegrationPagerdutyServiceObjectUpdate,
+		Delete: resourceDatadogIntegrationPagerdutyServiceObjectDelete,
+		Exists: resourceDatadogIntegrationPagerdutyServiceObjectExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceDatadogIntegrationPagerdutyServiceObjectCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	service := &integrationPagerdutyServiceObject{
+		ServiceName: d.Get("service_name").(string),
+		ServiceKey:  d.Get("service_key").(string),
+	}
+	if err := createIntegrationPagerdutyServiceObject(client, service); err != nil {
+		return fmt.Errorf("Failed to create integration pagerduty service object using Datadog API: %s", err.Error())
+	}
+
+	d.SetId(service.ServiceName)
+
+	return resourceDatadogIntegrationPagerdutyServiceObjectRead(d, meta)
+}
+
+func resourceDatadogIntegrationPagerdutyServiceObjectRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	service, err := getIntegrationPagerdutyServiceObject(client, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] pagerduty service %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// The service key is not returned, the one in the state is kept.
+	d.Set("service_name", service.ServiceName)
+
+	return nil
+}
+
+func resourceDatadogIntegrationPagerdutyServiceObjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	if err := updateIntegrationPagerdutyServiceObject(client, d.Id(), d.Get("service_key").(string)); err != nil {
+		return fmt.Errorf("Failed to update integration pagerduty service object using Datadog API: %s", err.Error())
+	}
+
+	return resourceDatadogIntegrationPagerdutyServiceObjectRead(d, meta)
+}
+
+func resourceDatadogIntegrationPagerdutyServiceObjectDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	if err := deleteIntegrationPagerdutyServiceObject(client, d.Id()); err != nil {
+		return fmt.Errorf("Error while deleting pagerduty service %s: %v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceDatadogIntegrationPagerdutyServiceObjectExists(d *schema.ResourceData, meta interface{}) (b bool, e error) {
+	client := meta.(*datadog.Client)
+
+	if _, err := getIntegrationPagerdutyServiceObject(client, d.Id()); err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,154 @@
+package datadog
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	datadog "github.com/zorkian/go-datadog-api"
+)
+
+func TestAccDatadogIntegrationPagerdutyServiceObject_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogIntegrationPagerdutyServiceObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogIntegrationPagerdutyServiceObjectConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogIntegrationPagerdutyServiceObjectExists("datadog_integration_pagerduty_service_object.testing_foo"),
+					testAccCheckDatadogIntegrationPagerdutyServiceObjectExists("datadog_integration_pagerduty_service_object.testing_bar"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_pagerduty_service_object.testing_foo", "service_name", "testing_foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_pagerduty_service_object.testing_foo", "service_key", "9876543210123456789"),
+					resource.TestCheckNoResourceAttr(
+						"datadog_integration_pagerduty.pd", "services.#"),
+					testAccCheckDatadogIntegrationPagerdutyServices("testing_bar", "testing_foo"),
+				),
+			},
+			{
+				// Updating the integration keeps the services.
+				Config: testAccCheckDatadogIntegrationPagerdutyServiceObjectConfigUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogIntegrationPagerdutyServiceObjectExists("datadog_integration_pagerduty_service_object.testing_foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_pagerduty.pd", "subdomain", "testdomain2"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_pagerduty_service_object.testing_foo", "service_key", "1234567890"),
+					testAccCheckDatadogIntegrationPagerdutyServices("testing_foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestDatadogIntegrationPagerdutyServiceObject_import(t *testing.T) {
+	resourceName := "datadog_integration_pagerduty_service_object.testing_foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogIntegrationPagerdutyServiceObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogIntegrationPagerdutyServiceObjectConfig,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"service_key"},
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogIntegrationPagerdutyServiceObjectExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*datadog.Client)
+		if _, err := getIntegrationPagerdutyServiceObject(client, s.RootModule().Resources[n].Primary.ID); err != nil {
+			return fmt.Errorf("Received an error retrieving pagerduty service %s", err)
+		}
+		return nil
+	}
+}
+
+// testAccCheckDatadogIntegrationPagerdutyServices checks the names of the
+// services of the integration, in any order since they are created in parallel.
+func testAccCheckDatadogIntegrationPagerdutyServices(names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*datadog.Client)
+		pd, err := client.GetIntegrationPD()
+		if err != nil {
+			return fmt.Errorf("Received an error retrieving integration pagerduty %s", err)
+		}
+		services := []string{}
+		for _, service := range pd.Services {
+			services = append(services, service.GetServiceName())
+		}
+		sort.Strings(services)
+		sort.Strings(names)
+		if fmt.Sprint(services) != fmt.Sprint(names) {
+			return fmt.Errorf("the pagerduty services are %v, expected %v", services, names)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogIntegrationPagerdutyServiceObjectDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*datadog.Client)
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "datadog_integration_pagerduty_service_object" {
+			continue
+		}
+		if _, err := getIntegrationPagerdutyServiceObject(client, r.Primary.ID); err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return fmt.Errorf("Received an error retrieving pagerduty service %s", err)
+		}
+		return fmt.Errorf("Pagerduty service still exists")
+	}
+	return nil
+}
+
+const testAccCheckDatadogIntegrationPagerdutyServiceObjectConfig = `
+resource "datadog_integration_pagerduty" "pd" {
+  subdomain           = "testdomain"
+  api_token           = "*****"
+  individual_services = true
+}
+
+resource "datadog_integration_pagerduty_service_object" "testing_foo" {
+  depends_on = ["datadog_integration_pagerduty.pd"]
+
+  service_name = "testing_foo"
+  service_key  = "9876543210123456789"
+}
+
+resource "datadog_integration_pagerduty_service_object" "testing_bar" {
+  depends_on = ["datadog_integration_pagerduty.pd"]
+
+  service_name = "testing_bar"
+  service_key  = "54321098765432109876"
+}
+`
+
+const testAccCheckDatadogIntegrationPagerdutyServiceObjectConfigUpdated = `
+resource "datadog_integration_pagerduty" "pd" {
+  subdomain           = "testdomain2"
+  api_token           = "*****"
+  individual_services = true
+}
+
+resource "datadog_integration_pagerduty_service_object" "testing_foo" {
+  depends_on = ["datadog_integration_pagerduty.pd"]
+
+  service_name = "testing_foo"
+  service_key  = "1234567890"
+}
+`
@@ -0,0 +1,185 @@
+package datadog
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/zorkian/go-datadog-api"
+)
+
+// The Slack integration is a singleton per organization, so the resource uses
+// a fixed ID.
+const integrationSlackID = "slack"
+
+func resourceDatadogIntegrationSlack() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatadogIntegrationSlackCreate,
+		Read:   resourceDatadogIntegrationSlackRead,
+		Update: resourceDatadogIntegrationSlackUpdate,
+		Delete: resourceDatadogIntegrationSlackDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_hooks": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "A list of Slack account names and their incoming webhook URLs.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"url": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"channels": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "A list of Slack channels that can be notified with @slack- handles.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"account": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"transfer_all_user_comments": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildIntegrationSlack(d *schema.ResourceData) (*datadog.IntegrationSlackRequest, error) {
+	slack := &datadog.IntegrationSlackRequest{}
+
+	serviceHooks := []datadog.ServiceHookSlackRequest{}
+	for _, hInterface := range d.Get("service_hooks").([]interface{}) {
+		h := hInterface.(map[string]interface{})
+
+		hook := datadog.ServiceHookSlackRequest{}
+		hook.SetAccount(h["account"].(string))
+		hook.SetUrl(h["url"].(string))
+
+		serviceHooks = append(serviceHooks, hook)
+	}
+	slack.ServiceHooks = serviceHooks
+
+	channels := []datadog.ChannelSlackRequest{}
+	for _, cInterface := range d.Get("channels").([]interface{}) {
+		c := cInterface.(map[string]interface{})
+
+		channel := datadog.ChannelSlackRequest{}
+		channel.SetChannelName(c["channel_name"].(string))
+		channel.SetAccount(c["account"].(string))
+		channel.SetTransferAllUserComments(c["transfer_all_user_comments"].(bool))
+
+		channels = append(channels, channel)
+	}
+	slack.Channels = channels
+
+	return slack, nil
+}
+
+func resourceDatadogIntegrationSlackCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	slack, err := buildIntegrationSlack(d)
+	if err != nil {
+		return fmt.Errorf("Failed to parse resource configuration: %s", err.Error())
+	}
+
+	// Creating the integration appends to any existing configuration, use
+	// update so that the resource owns the full list of hooks and channels.
+	if _, err := client.GetIntegrationSlack(); err == nil {
+		if err := client.UpdateIntegrationSlack(slack); err != nil {
+			return fmt.Errorf("Failed to create integration slack using Datadog API: %s", err.Error())
+		}
+	} else if !isNotFoundError(err) {
+		return fmt.Errorf("error retrieving integration slack: %s", err.Error())
+	} else if err := client.CreateIntegrationSlack(slack); err != nil {
+		return fmt.Errorf("Failed to create integration slack using Datadog API: %s", err.Error())
+	}
+
+	d.SetId(integrationSlackID)
+
+	return nil
+}
+
+func resourceDatadogIntegrationSlackRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	slack, err := client.GetIntegrationSlack()
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] slack integration not found, removing it from state")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	serviceHooks := []map[string]interface{}{}
+	for _, hook := range slack.ServiceHooks {
+		serviceHooks = append(serviceHooks, map[string]interface{}{
+			"account": hook.GetAccount(),
+			"url":     hook.GetUrl(),
+		})
+	}
+
+	channels := []map[string]interface{}{}
+	for _, channel := range slack.Channels {
+		channels = append(channels, map[string]interface{}{
+			"channel_name":               channel.GetChannelName(),
+			"account":                    channel.GetAccount(),
+			"transfer_all_user_comments": channel.GetTransferAllUserComments(),
+		})
+	}
+
+	d.Set("service_hooks", serviceHooks)
+	d.Set("channels", channels)
+
+	return nil
+}
+
+func resourceDatadogIntegrationSlackUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	slack, err := buildIntegrationSlack(d)
+	if err != nil {
+		return fmt.Errorf("Failed to parse resource configuration: %s", err.Error())
+	}
+
+	if err := client.UpdateIntegrationSlack(slack); err != nil {
+		return fmt.Errorf("Failed to update integration slack using Datadog API: %s", err.Error())
+	}
+
+	return resourceDatadogIntegrationSlackRead(d, meta)
+}
+
+func resourceDatadogIntegrationSlackDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	if err := client.DeleteIntegrationSlack(); err != nil {
+		return fmt.Errorf("Error while deleting integration: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package datadog
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	datadog "github.com/zorkian/go-datadog-api"
+)
+
+func TestAccDatadogIntegrationSlack_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogIntegrationSlackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogIntegrationSlackConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogIntegrationSlackExists("datadog_integration_slack.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_slack.foo", "service_hooks.#", "1"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_slack.foo", "service_hooks.0.account", "test_account"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_slack.foo", "service_hooks.0.url", "https://hooks.slack.com/services/1/1/1"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_slack.foo", "channels.#", "1"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_slack.foo", "channels.0.channel_name", "#test_channel"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_slack.foo", "channels.0.account", "test_account"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_slack.foo", "channels.0.transfer_all_user_comments", "false"),
+				),
+			},
+			{
+				Config: testAccCheckDatadogIntegrationSlackConfigUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogIntegrationSlackExists("datadog_integration_slack.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_slack.foo", "service_hooks.#", "1"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_slack.foo", "channels.#", "2"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_slack.foo", "channels.0.channel_name", "#test_channel"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_slack.foo", "channels.0.transfer_all_user_comments", "true"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_slack.foo", "channels.1.channel_name", "#test_channel_2"),
+				),
+			},
+		},
+	})
+}
+
+func TestDatadogIntegrationSlack_import(t *testing.T) {
+	resourceName := "datadog_integration_slack.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogIntegrationSlackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogIntegrationSlackConfigUpdated,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogIntegrationSlackExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*datadog.Client)
+		if _, err := client.GetIntegrationSlack(); err != nil {
+			return fmt.Errorf("Received an error retrieving integration slack %s", err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogIntegrationSlackDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*datadog.Client)
+
+	_, err := client.GetIntegrationSlack()
+	if err != nil {
+		if strings.Contains(err.Error(), "slack not found") {
+			return nil
+		}
+
+		return fmt.Errorf("Received an error retrieving integration slack %s", err)
+	}
+
+	return fmt.Errorf("Integration slack is not properly destroyed")
+}
+
+const testAccCheckDatadogIntegrationSlackConfig = `
+resource "datadog_integration_slack" "foo" {
+  service_hooks {
+    account = "test_account"
+    url     = "https://hooks.slack.com/services/1/1/1"
+  }
+
+  channels {
+    channel_name = "#test_channel"
+    account      = "test_account"
+  }
+}
+`
+
+const testAccCheckDatadogIntegrationSlackConfigUpdated = `
+resource "datadog_integration_slack" "foo" {
+  service_hooks {
+    account = "test_account"
+    url     = "https://hooks.slack.com/services/1/1/1"
+  }
+
+  channels {
+    channel_name               = "#test_channel"
+    account                    = "test_account"
+    transfer_all_user_comments = true
+  }
+
+  channels {
+    channel_name = "#test_channel_2"
+    account      = "test_account"
+  }
+}
+`
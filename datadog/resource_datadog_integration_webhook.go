@@ -0,0 +1,175 @@
+package datadog
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/zorkian/go-datadog-api"
+)
+
+// Each webhook of the webhooks integration is managed on its own, unlike the
+// PagerDuty and Slack integrations. The webhook name is the resource ID.
+func resourceDatadogIntegrationWebhook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatadogIntegrationWebhookCreate,
+		Read:   resourceDatadogIntegrationWebhookRead,
+		Update: resourceDatadogIntegrationWebhookUpdate,
+		Delete: resourceDatadogIntegrationWebhookDelete,
+		Exists: resourceDatadogIntegrationWebhookExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The name of the webhook, monitors notify it with @webhook-<name>.",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must only contain letters, digits, underscores and dashes"),
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"custom_payload": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "A JSON payload template, the default Datadog payload is sent when unset.",
+				ValidateFunc: validation.ValidateJsonString,
+			},
+			"headers": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			"encode_as_form": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the payload is sent URL-encoded instead of as JSON.",
+			},
+		},
+	}
+}
+
+func buildIntegrationWebhook(d *schema.ResourceData) (*integrationWebhook, error) {
+	webhook := &integrationWebhook{
+		Name:     d.Get("name").(string),
+		URL:      d.Get("url").(string),
+		EncodeAs: "json",
+	}
+
+	if attr, ok := d.GetOk("custom_payload"); ok {
+		payload := attr.(string)
+		webhook.Payload = &payload
+	}
+
+	if attr, ok := d.GetOk("headers"); ok {
+		b, err := json.Marshal(attr.(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		headers := string(b)
+		webhook.CustomHeaders = &headers
+	}
+
+	if d.Get("encode_as_form").(bool) {
+		webhook.EncodeAs = "form"
+	}
+
+	return webhook, nil
+}
+
+func resourceDatadogIntegrationWebhookCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	webhook, err := buildIntegrationWebhook(d)
+	if err != nil {
+		return fmt.Errorf("Failed to parse resource configuration: %s", err.Error())
+	}
+
+	if _, err := createIntegrationWebhook(client, webhook); err != nil {
+		return fmt.Errorf("Failed to create integration webhook using Datadog API: %s", err.Error())
+	}
+
+	d.SetId(webhook.Name)
+
+	return resourceDatadogIntegrationWebhookRead(d, meta)
+}
+
+func resourceDatadogIntegrationWebhookRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	webhook, err := getIntegrationWebhook(client, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] webhook %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	headers := map[string]interface{}{}
+	if webhook.CustomHeaders != nil && *webhook.CustomHeaders != "" {
+		if err := json.Unmarshal([]byte(*webhook.CustomHeaders), &headers); err != nil {
+			return fmt.Errorf("error parsing the headers of webhook %s: %s", d.Id(), err.Error())
+		}
+	}
+
+	payload := ""
+	if webhook.Payload != nil {
+		payload = *webhook.Payload
+	}
+
+	d.Set("name", webhook.Name)
+	d.Set("url", webhook.URL)
+	d.Set("custom_payload", payload)
+	d.Set("headers", headers)
+	d.Set("encode_as_form", webhook.EncodeAs == "form")
+
+	return nil
+}
+
+func resourceDatadogIntegrationWebhookUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	webhook, err := buildIntegrationWebhook(d)
+	if err != nil {
+		return fmt.Errorf("Failed to parse resource configuration: %s", err.Error())
+	}
+
+	if _, err := updateIntegrationWebhook(client, d.Id(), webhook); err != nil {
+		return fmt.Errorf("Failed to update integration webhook using Datadog API: %s", err.Error())
+	}
+
+	return resourceDatadogIntegrationWebhookRead(d, meta)
+}
+
+func resourceDatadogIntegrationWebhookDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	if err := deleteIntegrationWebhook(client, d.Id()); err != nil {
+		return fmt.Errorf("Error while deleting webhook: %v", err)
+	}
+
+	return nil
+}
+
+func resourceDatadogIntegrationWebhookExists(d *schema.ResourceData, meta interface{}) (b bool, e error) {
+	client := meta.(*datadog.Client)
+
+	if _, err := getIntegrationWebhook(client, d.Id()); err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,152 @@
+package datadog
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	datadog "github.com/zorkian/go-datadog-api"
+)
+
+func TestAccDatadogIntegrationWebhook_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogIntegrationWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogIntegrationWebhookConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogIntegrationWebhookExists("datadog_integration_webhook.foo"),
+					testAccCheckDatadogIntegrationWebhookExists("datadog_integration_webhook.bar"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_webhook.foo", "name", "tf-test-foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_webhook.foo", "url", "https://example.com/foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_webhook.foo", "custom_payload", `{"title": "$EVENT_TITLE", "id": "$ALERT_ID"}`),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_webhook.foo", "headers.%", "1"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_webhook.foo", "headers.Authorization", "Bearer secret"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_webhook.foo", "encode_as_form", "false"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_webhook.bar", "custom_payload", ""),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_webhook.bar", "headers.%", "0"),
+					resource.TestCheckResourceAttr(
+						"datadog_monitor.foo", "message", "Something happened @webhook-tf-test-foo"),
+				),
+			},
+			{
+				Config: testAccCheckDatadogIntegrationWebhookConfigUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogIntegrationWebhookExists("datadog_integration_webhook.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_webhook.foo", "url", "https://example.com/foo/v2"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_webhook.foo", "custom_payload", ""),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_webhook.foo", "headers.%", "2"),
+					resource.TestCheckResourceAttr(
+						"datadog_integration_webhook.foo", "encode_as_form", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestDatadogIntegrationWebhook_import(t *testing.T) {
+	resourceName := "datadog_integration_webhook.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogIntegrationWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogIntegrationWebhookConfig,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogIntegrationWebhookExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*datadog.Client)
+		if _, err := getIntegrationWebhook(client, s.RootModule().Resources[n].Primary.ID); err != nil {
+			return fmt.Errorf("Received an error retrieving webhook %s", err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogIntegrationWebhookDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*datadog.Client)
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "datadog_integration_webhook" {
+			continue
+		}
+		if _, err := getIntegrationWebhook(client, r.Primary.ID); err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return fmt.Errorf("Received an error retrieving webhook %s", err)
+		}
+		return fmt.Errorf("Webhook still exists")
+	}
+	return nil
+}
+
+const testAccCheckDatadogIntegrationWebhookConfig = `
+resource "datadog_integration_webhook" "foo" {
+  name           = "tf-test-foo"
+  url            = "https://example.com/foo"
+  custom_payload = "{\"title\": \"$EVENT_TITLE\", \"id\": \"$ALERT_ID\"}"
+
+  headers {
+    Authorization = "Bearer secret"
+  }
+}
+
+resource "datadog_integration_webhook" "bar" {
+  name = "tf-test-bar"
+  url  = "https://example.com/bar"
+}
+
+resource "datadog_monitor" "foo" {
+  name    = "monitor notifying a webhook"
+  type    = "query alert"
+  message = "Something happened @webhook-${datadog_integration_webhook.foo.name}"
+  query   = "avg(last_1h):avg:aws.ec2.cpu{environment:foo,host:foo} by {host} > 2"
+
+  thresholds {
+    critical = 2
+  }
+}
+`
+
+const testAccCheckDatadogIntegrationWebhookConfigUpdated = `
+resource "datadog_integration_webhook" "foo" {
+  name           = "tf-test-foo"
+  url            = "https://example.com/foo/v2"
+  encode_as_form = true
+
+  headers {
+    Authorization = "Bearer secret"
+    X-Source      = "datadog"
+  }
+}
+
+resource "datadog_integration_webhook" "bar" {
+  name = "tf-test-bar"
+  url  = "https://example.com/bar"
+}
+`
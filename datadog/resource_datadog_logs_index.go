@@ -0,0 +1,203 @@
+package datadog
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/zorkian/go-datadog-api"
+)
+
+// Logs indexes can't be created or deleted through the Datadog API. The
+// resource takes over an existing index on creation and only removes it from
+// the state on destruction.
+func resourceDatadogLogsIndex() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatadogLogsIndexCreate,
+		Read:   resourceDatadogLogsIndexRead,
+		Update: resourceDatadogLogsIndexUpdate,
+		Delete: resourceDatadogLogsIndexDelete,
+		Exists: resourceDatadogLogsIndexExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of an existing index.",
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     logsFilterSchema(),
+			},
+			"daily_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The number of log events the index can hold each day, unlimited when unset.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"exclusion_filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"is_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"query": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"sample_rate": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										Default:      1.0,
+										ValidateFunc: validateLogsExclusionFilterSampleRate,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildLogsIndexStruct(d *schema.ResourceData) *logsIndex {
+	index := &logsIndex{
+		Name:             d.Get("name").(string),
+		Filter:           logsFilter{Query: d.Get("filter.0.query").(string)},
+		DailyLimit:       d.Get("daily_limit").(int),
+		ExclusionFilters: []logsExclusionFilter{},
+	}
+	if index.DailyLimit == 0 {
+		index.DisableDailyLimit = true
+	}
+
+	for _, fInterface := range d.Get("exclusion_filter").([]interface{}) {
+		f := fInterface.(map[string]interface{})
+		exclusionFilter := logsExclusionFilter{
+			Name:      f["name"].(string),
+			IsEnabled: f["is_enabled"].(bool),
+		}
+		if filter, ok := f["filter"].([]interface{}); ok && len(filter) > 0 && filter[0] != nil {
+			q := filter[0].(map[string]interface{})
+			exclusionFilter.Filter.Query = q["query"].(string)
+			exclusionFilter.Filter.SampleRate = q["sample_rate"].(float64)
+		}
+		index.ExclusionFilters = append(index.ExclusionFilters, exclusionFilter)
+	}
+
+	return index
+}
+
+func resourceDatadogLogsIndexCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	name := d.Get("name").(string)
+	if _, err := getLogsIndex(client, name); err != nil {
+		if isNotFoundError(err) {
+			return fmt.Errorf("logs index %s does not exist: indexes can't be created through the Datadog API, create it in the Datadog app first", name)
+		}
+		return fmt.Errorf("error retrieving logs index %s: %s", name, err.Error())
+	}
+
+	if _, err := updateLogsIndex(client, name, buildLogsIndexStruct(d)); err != nil {
+		return fmt.Errorf("error updating logs index %s: %s", name, err.Error())
+	}
+
+	d.SetId(name)
+
+	return resourceDatadogLogsIndexRead(d, meta)
+}
+
+func resourceDatadogLogsIndexRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	index, err := getLogsIndex(client, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] logs index %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	exclusionFilters := []map[string]interface{}{}
+	for _, f := range index.ExclusionFilters {
+		exclusionFilters = append(exclusionFilters, map[string]interface{}{
+			"name":       f.Name,
+			"is_enabled": f.IsEnabled,
+			"filter": []map[string]interface{}{{
+				"query":       f.Filter.Query,
+				"sample_rate": f.Filter.SampleRate,
+			}},
+		})
+	}
+
+	log.Printf("[DEBUG] logs index: %+v", index)
+	d.Set("name", index.Name)
+	d.Set("daily_limit", index.DailyLimit)
+	if err := d.Set("filter", []map[string]interface{}{{"query": index.Filter.Query}}); err != nil {
+		return err
+	}
+	if err := d.Set("exclusion_filter", exclusionFilters); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceDatadogLogsIndexUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	if _, err := updateLogsIndex(client, d.Id(), buildLogsIndexStruct(d)); err != nil {
+		return fmt.Errorf("error updating logs index %s: %s", d.Id(), err.Error())
+	}
+
+	return resourceDatadogLogsIndexRead(d, meta)
+}
+
+func resourceDatadogLogsIndexDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] logs indexes can't be deleted through the Datadog API, logs index %s is only removed from the state and keeps its filters", d.Id())
+	return nil
+}
+
+func resourceDatadogLogsIndexExists(d *schema.ResourceData, meta interface{}) (b bool, e error) {
+	client := meta.(*datadog.Client)
+
+	if _, err := getLogsIndex(client, d.Id()); err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func validateLogsExclusionFilterSampleRate(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(float64)
+	if value < 0 || value > 1 {
+		errors = append(errors, fmt.Errorf("%q must be between 0 and 1, got %v", k, value))
+	}
+	return
+}
@@ -0,0 +1,92 @@
+package datadog
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/zorkian/go-datadog-api"
+)
+
+// The index order is a singleton per organization, the resource uses its
+// name as ID.
+func resourceDatadogLogsIndexOrder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatadogLogsIndexOrderCreate,
+		Read:   resourceDatadogLogsIndexOrderRead,
+		Update: resourceDatadogLogsIndexOrderUpdate,
+		Delete: resourceDatadogLogsIndexOrderDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDatadogLogsIndexOrderImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"indexes": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The names of all the indexes of the organization, in the order logs are matched against their filters.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func buildLogsIndexOrder(d *schema.ResourceData) *logsIndexOrder {
+	order := &logsIndexOrder{IndexNames: []string{}}
+	for _, name := range d.Get("indexes").([]interface{}) {
+		order.IndexNames = append(order.IndexNames, name.(string))
+	}
+	return order
+}
+
+func resourceDatadogLogsIndexOrderCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	if _, err := updateLogsIndexOrder(client, buildLogsIndexOrder(d)); err != nil {
+		return fmt.Errorf("error updating logs index order: %s", err.Error())
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return resourceDatadogLogsIndexOrderRead(d, meta)
+}
+
+func resourceDatadogLogsIndexOrderRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	order, err := getLogsIndexOrder(client)
+	if err != nil {
+		return err
+	}
+
+	d.Set("indexes", order.IndexNames)
+
+	return nil
+}
+
+func resourceDatadogLogsIndexOrderUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	if _, err := updateLogsIndexOrder(client, buildLogsIndexOrder(d)); err != nil {
+		return fmt.Errorf("error updating logs index order: %s", err.Error())
+	}
+
+	return resourceDatadogLogsIndexOrderRead(d, meta)
+}
+
+// The index order can't be deleted, destroying the resource leaves the
+// indexes in their current order.
+func resourceDatadogLogsIndexOrderDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] logs index order %s removed from state, the indexes keep their order", d.Id())
+	return nil
+}
+
+func resourceDatadogLogsIndexOrderImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("name", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,212 @@
+package datadog
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	datadog "github.com/zorkian/go-datadog-api"
+)
+
+func TestAccDatadogLogsIndex_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogLogsIndexDetached,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDatadogLogsIndexConfigMissing,
+				ExpectError: regexp.MustCompile("indexes can't be created through the Datadog API"),
+			},
+			{
+				Config: testAccCheckDatadogLogsIndexConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogLogsIndexExists("datadog_logs_index.main"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_index.main", "name", "main"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_index.main", "filter.0.query", "source:web"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_index.main", "daily_limit", "200000"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_index.main", "exclusion_filter.#", "2"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_index.main", "exclusion_filter.0.name", "Drop health checks"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_index.main", "exclusion_filter.0.is_enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_index.main", "exclusion_filter.0.filter.0.sample_rate", "1"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_index.main", "exclusion_filter.1.filter.0.query", "status:info"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_index.main", "exclusion_filter.1.filter.0.sample_rate", "0.9"),
+				),
+			},
+			{
+				Config: testAccCheckDatadogLogsIndexConfigUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogLogsIndexExists("datadog_logs_index.main"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_index.main", "filter.0.query", "source:(web OR api)"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_index.main", "daily_limit", "0"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_index.main", "exclusion_filter.#", "1"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_index.main", "exclusion_filter.0.is_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestDatadogLogsIndex_import(t *testing.T) {
+	resourceName := "datadog_logs_index.main"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogLogsIndexDetached,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogLogsIndexConfig,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDatadogLogsIndexOrder_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogLogsIndexOrderConfig("debug", "main"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogLogsIndexOrder("debug", "main"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_index_order.order", "indexes.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckDatadogLogsIndexOrderConfig("main", "debug"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogLogsIndexOrder("main", "debug"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogLogsIndexExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*datadog.Client)
+		if _, err := getLogsIndex(client, s.RootModule().Resources[n].Primary.ID); err != nil {
+			return fmt.Errorf("Received an error retrieving logs index %s", err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogLogsIndexOrder(names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*datadog.Client)
+		order, err := getLogsIndexOrder(client)
+		if err != nil {
+			return fmt.Errorf("Received an error retrieving logs index order %s", err)
+		}
+		if fmt.Sprint(order.IndexNames) != fmt.Sprint(names) {
+			return fmt.Errorf("the index order is %v, expected %v", order.IndexNames, names)
+		}
+		return nil
+	}
+}
+
+// testAccCheckDatadogLogsIndexDetached checks that destroyed indexes are left
+// in place, since they can't be deleted.
+func testAccCheckDatadogLogsIndexDetached(s *terraform.State) error {
+	client := testAccProvider.Meta().(*datadog.Client)
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "datadog_logs_index" {
+			continue
+		}
+		if _, err := getLogsIndex(client, r.Primary.ID); err != nil {
+			return fmt.Errorf("Received an error retrieving logs index %s", err)
+		}
+	}
+	return nil
+}
+
+const testAccCheckDatadogLogsIndexConfig = `
+resource "datadog_logs_index" "main" {
+  name        = "main"
+  daily_limit = 200000
+
+  filter {
+    query = "source:web"
+  }
+
+  exclusion_filter {
+    name       = "Drop health checks"
+    is_enabled = true
+
+    filter {
+      query = "@http.url_details.path:/health"
+    }
+  }
+
+  exclusion_filter {
+    name       = "Sample info logs"
+    is_enabled = true
+
+    filter {
+      query       = "status:info"
+      sample_rate = 0.9
+    }
+  }
+}
+`
+
+const testAccCheckDatadogLogsIndexConfigUpdated = `
+resource "datadog_logs_index" "main" {
+  name = "main"
+
+  filter {
+    query = "source:(web OR api)"
+  }
+
+  exclusion_filter {
+    name = "Drop health checks"
+
+    filter {
+      query = "@http.url_details.path:/health"
+    }
+  }
+}
+`
+
+const testAccCheckDatadogLogsIndexConfigMissing = `
+resource "datadog_logs_index" "missing" {
+  name = "does-not-exist"
+
+  filter {
+    query = "*"
+  }
+}
+`
+
+func testAccCheckDatadogLogsIndexOrderConfig(indexes ...string) string {
+	return fmt.Sprintf(`
+resource "datadog_logs_index_order" "order" {
+  name    = "order"
+  indexes = ["%s", "%s"]
+}
+`, indexes[0], indexes[1])
+}
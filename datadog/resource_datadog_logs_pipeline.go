@@ -0,0 +1,536 @@
+package datadog
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/zorkian/go-datadog-api"
+)
+
+// logsProcessorTypes maps the processor blocks of datadog_logs_pipeline to the
+// processor types of the API.
+var logsProcessorTypes = map[string]string{
+	"grok_parser":              "grok-parser",
+	"attribute_remapper":       "attribute-remapper",
+	"date_remapper":            "date-remapper",
+	"status_remapper":          "status-remapper",
+	"category_processor":       "category-processor",
+	"arithmetic_processor":     "arithmetic-processor",
+	"string_builder_processor": "string-builder-processor",
+	"url_parser":               "url-parser",
+	"user_agent_parser":        "user-agent-parser",
+}
+
+func resourceDatadogLogsPipeline() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatadogLogsPipelineCreate,
+		Read:   resourceDatadogLogsPipelineRead,
+		Update: resourceDatadogLogsPipelineUpdate,
+		Delete: resourceDatadogLogsPipelineDelete,
+		Exists: resourceDatadogLogsPipelineExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceDatadogLogsPipelineCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"is_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     logsFilterSchema(),
+			},
+			"processor": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grok_parser":              logsProcessorSchema(logsGrokParserSchema()),
+						"attribute_remapper":       logsProcessorSchema(logsAttributeRemapperSchema()),
+						"date_remapper":            logsProcessorSchema(logsSourcesSchema()),
+						"status_remapper":          logsProcessorSchema(logsSourcesSchema()),
+						"category_processor":       logsProcessorSchema(logsCategoryProcessorSchema()),
+						"arithmetic_processor":     logsProcessorSchema(logsArithmeticProcessorSchema()),
+						"string_builder_processor": logsProcessorSchema(logsStringBuilderProcessorSchema()),
+						"url_parser":               logsProcessorSchema(logsURLParserSchema()),
+						"user_agent_parser":        logsProcessorSchema(logsUserAgentParserSchema()),
+					},
+				},
+			},
+		},
+	}
+}
+
+func logsFilterSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+// logsProcessorSchema returns the block of a processor type, with the
+// attributes all processors share.
+func logsProcessorSchema(attributes map[string]*schema.Schema) *schema.Schema {
+	attributes["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	attributes["is_enabled"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem:     &schema.Resource{Schema: attributes},
+	}
+}
+
+func logsSourcesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"sources": {
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func logsGrokParserSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"source": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"samples": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 5,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"grok": {
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"support_rules": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"match_rules": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func logsAttributeRemapperSchema() map[string]*schema.Schema {
+	attributes := logsSourcesSchema()
+	attributes["source_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "attribute",
+		ValidateFunc: validation.StringInSlice([]string{"attribute", "tag"}, false),
+	}
+	attributes["target"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	attributes["target_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "attribute",
+		ValidateFunc: validation.StringInSlice([]string{"attribute", "tag"}, false),
+	}
+	attributes["preserve_source"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+	attributes["override_on_conflict"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+	return attributes
+}
+
+func logsCategoryProcessorSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"target": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"category": {
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"filter": {
+						Type:     schema.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem:     logsFilterSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func logsArithmeticProcessorSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"expression": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"target": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"is_replace_missing": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+	}
+}
+
+func logsStringBuilderProcessorSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"template": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"target": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"is_replace_missing": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+	}
+}
+
+func logsURLParserSchema() map[string]*schema.Schema {
+	attributes := logsSourcesSchema()
+	attributes["target"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	attributes["normalize_ending_slashes"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+	return attributes
+}
+
+func logsUserAgentParserSchema() map[string]*schema.Schema {
+	attributes := logsSourcesSchema()
+	attributes["target"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	attributes["is_encoded"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+	return attributes
+}
+
+// resourceDatadogLogsPipelineCustomizeDiff checks that every processor block
+// holds exactly one processor, which the schema can't express.
+func resourceDatadogLogsPipelineCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	for i, pInterface := range diff.Get("processor").([]interface{}) {
+		if _, _, err := logsProcessorBlock(i, pInterface); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// logsProcessorBlock returns the name and attributes of the single processor
+// set in the i-th processor block.
+func logsProcessorBlock(i int, pInterface interface{}) (string, map[string]interface{}, error) {
+	p, _ := pInterface.(map[string]interface{})
+
+	var names []string
+	var attributes map[string]interface{}
+	for name := range logsProcessorTypes {
+		if blocks, ok := p[name].([]interface{}); ok && len(blocks) > 0 {
+			names = append(names, name)
+			attributes, _ = blocks[0].(map[string]interface{})
+		}
+	}
+	if len(names) != 1 {
+		sort.Strings(names)
+		return "", nil, fmt.Errorf("processor.%d must contain exactly one processor, got %d (%s)", i, len(names), strings.Join(names, ", "))
+	}
+	if attributes == nil {
+		attributes = map[string]interface{}{}
+	}
+	return names[0], attributes, nil
+}
+
+func buildLogsPipelineStruct(d *schema.ResourceData) (*logsPipeline, error) {
+	pipeline := &logsPipeline{
+		Name:       d.Get("name").(string),
+		IsEnabled:  d.Get("is_enabled").(bool),
+		Filter:     logsFilter{Query: d.Get("filter.0.query").(string)},
+		Processors: []logsProcessor{},
+	}
+
+	for i, pInterface := range d.Get("processor").([]interface{}) {
+		name, attributes, err := logsProcessorBlock(i, pInterface)
+		if err != nil {
+			return nil, err
+		}
+		pipeline.Processors = append(pipeline.Processors, buildLogsProcessor(name, attributes))
+	}
+
+	return pipeline, nil
+}
+
+func buildLogsProcessor(name string, p map[string]interface{}) logsProcessor {
+	processor := logsProcessor{
+		Type:      logsProcessorTypes[name],
+		Name:      p["name"].(string),
+		IsEnabled: p["is_enabled"].(bool),
+	}
+
+	if sources, ok := p["sources"].([]interface{}); ok {
+		for _, s := range sources {
+			processor.Sources = append(processor.Sources, s.(string))
+		}
+	}
+	if target, ok := p["target"].(string); ok {
+		processor.Target = target
+	}
+
+	switch name {
+	case "grok_parser":
+		processor.Source = p["source"].(string)
+		for _, s := range p["samples"].([]interface{}) {
+			processor.Samples = append(processor.Samples, s.(string))
+		}
+		processor.Grok = &logsGrokRules{}
+		if grok, ok := p["grok"].([]interface{}); ok && len(grok) > 0 && grok[0] != nil {
+			rules := grok[0].(map[string]interface{})
+			processor.Grok.SupportRules = rules["support_rules"].(string)
+			processor.Grok.MatchRules = rules["match_rules"].(string)
+		}
+	case "attribute_remapper":
+		processor.SourceType = p["source_type"].(string)
+		processor.TargetType = p["target_type"].(string)
+		processor.PreserveSource = datadog.Bool(p["preserve_source"].(bool))
+		processor.OverrideOnConflict = datadog.Bool(p["override_on_conflict"].(bool))
+	case "category_processor":
+		for _, cInterface := range p["category"].([]interface{}) {
+			c := cInterface.(map[string]interface{})
+			category := logsCategory{Name: c["name"].(string)}
+			if filter, ok := c["filter"].([]interface{}); ok && len(filter) > 0 && filter[0] != nil {
+				category.Filter.Query = filter[0].(map[string]interface{})["query"].(string)
+			}
+			processor.Categories = append(processor.Categories, category)
+		}
+	case "arithmetic_processor":
+		processor.Expression = p["expression"].(string)
+		processor.IsReplaceMissing = datadog.Bool(p["is_replace_missing"].(bool))
+	case "string_builder_processor":
+		processor.Template = p["template"].(string)
+		processor.IsReplaceMissing = datadog.Bool(p["is_replace_missing"].(bool))
+	case "url_parser":
+		processor.NormalizeEndingSlashes = datadog.Bool(p["normalize_ending_slashes"].(bool))
+	case "user_agent_parser":
+		processor.IsEncoded = datadog.Bool(p["is_encoded"].(bool))
+	}
+
+	return processor
+}
+
+// flattenLogsProcessor turns an API processor into a processor block. It
+// returns false for processor types the provider doesn't support.
+func flattenLogsProcessor(processor logsProcessor) (map[string]interface{}, bool) {
+	var name string
+	for n, t := range logsProcessorTypes {
+		if t == processor.Type {
+			name = n
+		}
+	}
+	if name == "" {
+		return nil, false
+	}
+
+	p := map[string]interface{}{
+		"name":       processor.Name,
+		"is_enabled": processor.IsEnabled,
+	}
+
+	switch name {
+	case "grok_parser":
+		p["source"] = processor.Source
+		p["samples"] = processor.Samples
+		grok := []map[string]interface{}{}
+		if processor.Grok != nil {
+			grok = append(grok, map[string]interface{}{
+				"support_rules": processor.Grok.SupportRules,
+				"match_rules":   processor.Grok.MatchRules,
+			})
+		}
+		p["grok"] = grok
+	case "attribute_remapper":
+		p["sources"] = processor.Sources
+		p["source_type"] = processor.SourceType
+		p["target"] = processor.Target
+		p["target_type"] = processor.TargetType
+		p["preserve_source"] = processor.PreserveSource != nil && *processor.PreserveSource
+		p["override_on_conflict"] = processor.OverrideOnConflict != nil && *processor.OverrideOnConflict
+	case "date_remapper", "status_remapper":
+		p["sources"] = processor.Sources
+	case "category_processor":
+		p["target"] = processor.Target
+		categories := []map[string]interface{}{}
+		for _, c := range processor.Categories {
+			categories = append(categories, map[string]interface{}{
+				"name":   c.Name,
+				"filter": []map[string]interface{}{{"query": c.Filter.Query}},
+			})
+		}
+		p["category"] = categories
+	case "arithmetic_processor":
+		p["expression"] = processor.Expression
+		p["target"] = processor.Target
+		p["is_replace_missing"] = processor.IsReplaceMissing != nil && *processor.IsReplaceMissing
+	case "string_builder_processor":
+		p["template"] = processor.Template
+		p["target"] = processor.Target
+		p["is_replace_missing"] = processor.IsReplaceMissing != nil && *processor.IsReplaceMissing
+	case "url_parser":
+		p["sources"] = processor.Sources
+		p["target"] = processor.Target
+		p["normalize_ending_slashes"] = processor.NormalizeEndingSlashes != nil && *processor.NormalizeEndingSlashes
+	case "user_agent_parser":
+		p["sources"] = processor.Sources
+		p["target"] = processor.Target
+		p["is_encoded"] = processor.IsEncoded != nil && *processor.IsEncoded
+	}
+
+	return map[string]interface{}{name: []map[string]interface{}{p}}, true
+}
+
+func resourceDatadogLogsPipelineCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	pipeline, err := buildLogsPipelineStruct(d)
+	if err != nil {
+		return err
+	}
+
+	pipeline, err = createLogsPipeline(client, pipeline)
+	if err != nil {
+		return fmt.Errorf("error creating logs pipeline: %s", err.Error())
+	}
+
+	d.SetId(pipeline.ID)
+
+	return resourceDatadogLogsPipelineRead(d, meta)
+}
+
+func resourceDatadogLogsPipelineRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	pipeline, err := getLogsPipeline(client, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] logs pipeline %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// Updates replace every processor, ignoring one that can't be represented
+	// would delete it on the next apply.
+	processors := []map[string]interface{}{}
+	for i, processor := range pipeline.Processors {
+		p, ok := flattenLogsProcessor(processor)
+		if !ok {
+			return fmt.Errorf("logs pipeline %s has a %s processor at position %d, which datadog_logs_pipeline doesn't support: remove it from the pipeline or manage the pipeline outside of Terraform", d.Id(), processor.Type, i)
+		}
+		processors = append(processors, p)
+	}
+
+	log.Printf("[DEBUG] logs pipeline: %+v", pipeline)
+	d.Set("name", pipeline.Name)
+	d.Set("is_enabled", pipeline.IsEnabled)
+	if err := d.Set("filter", []map[string]interface{}{{"query": pipeline.Filter.Query}}); err != nil {
+		return err
+	}
+	if err := d.Set("processor", processors); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceDatadogLogsPipelineUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	pipeline, err := buildLogsPipelineStruct(d)
+	if err != nil {
+		return err
+	}
+
+	if _, err := updateLogsPipeline(client, d.Id(), pipeline); err != nil {
+		return fmt.Errorf("error updating logs pipeline: %s", err.Error())
+	}
+
+	return resourceDatadogLogsPipelineRead(d, meta)
+}
+
+func resourceDatadogLogsPipelineDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	if err := deleteLogsPipeline(client, d.Id()); err != nil {
+		return fmt.Errorf("error deleting logs pipeline: %s", err.Error())
+	}
+
+	return nil
+}
+
+func resourceDatadogLogsPipelineExists(d *schema.ResourceData, meta interface{}) (b bool, e error) {
+	client := meta.(*datadog.Client)
+
+	if _, err := getLogsPipeline(client, d.Id()); err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,92 @@
+package datadog
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/zorkian/go-datadog-api"
+)
+
+// The pipeline order is a singleton per organization, the resource uses its
+// name as ID.
+func resourceDatadogLogsPipelineOrder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatadogLogsPipelineOrderCreate,
+		Read:   resourceDatadogLogsPipelineOrderRead,
+		Update: resourceDatadogLogsPipelineOrderUpdate,
+		Delete: resourceDatadogLogsPipelineOrderDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDatadogLogsPipelineOrderImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"pipelines": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The IDs of all the pipelines of the organization, in processing order.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func buildLogsPipelineOrder(d *schema.ResourceData) *logsPipelineOrder {
+	order := &logsPipelineOrder{PipelineIDs: []string{}}
+	for _, id := range d.Get("pipelines").([]interface{}) {
+		order.PipelineIDs = append(order.PipelineIDs, id.(string))
+	}
+	return order
+}
+
+func resourceDatadogLogsPipelineOrderCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	if _, err := updateLogsPipelineOrder(client, buildLogsPipelineOrder(d)); err != nil {
+		return fmt.Errorf("error updating logs pipeline order: %s", err.Error())
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return resourceDatadogLogsPipelineOrderRead(d, meta)
+}
+
+func resourceDatadogLogsPipelineOrderRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	order, err := getLogsPipelineOrder(client)
+	if err != nil {
+		return err
+	}
+
+	d.Set("pipelines", order.PipelineIDs)
+
+	return nil
+}
+
+func resourceDatadogLogsPipelineOrderUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	if _, err := updateLogsPipelineOrder(client, buildLogsPipelineOrder(d)); err != nil {
+		return fmt.Errorf("error updating logs pipeline order: %s", err.Error())
+	}
+
+	return resourceDatadogLogsPipelineOrderRead(d, meta)
+}
+
+// The pipeline order can't be deleted, destroying the resource leaves the
+// pipelines in their current order.
+func resourceDatadogLogsPipelineOrderDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] logs pipeline order %s removed from state, the pipelines keep their order", d.Id())
+	return nil
+}
+
+func resourceDatadogLogsPipelineOrderImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("name", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
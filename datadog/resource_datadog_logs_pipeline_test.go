@@ -0,0 +1,403 @@
+package datadog
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	datadog "github.com/zorkian/go-datadog-api"
+)
+
+func TestAccDatadogLogsPipeline_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogLogsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDatadogLogsPipelineConfigTwoProcessors,
+				ExpectError: regexp.MustCompile(`processor.0 must contain exactly one processor, got 2 \(date_remapper, status_remapper\)`),
+			},
+			{
+				Config: testAccCheckDatadogLogsPipelineConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogLogsPipelineExists("datadog_logs_pipeline.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "name", "my pipeline"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "is_enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "filter.0.query", "source:foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "processor.#", "9"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "processor.0.grok_parser.0.source", "message"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "processor.0.grok_parser.0.samples.#", "1"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "processor.0.grok_parser.0.grok.0.match_rules", "rule %{date(\"yyyy-MM-dd HH:mm:ss\"):date} %{word:status} %{data:msg}"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "processor.1.attribute_remapper.0.source_type", "tag"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "processor.1.attribute_remapper.0.target_type", "attribute"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "processor.1.attribute_remapper.0.preserve_source", "true"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "processor.2.date_remapper.0.sources.0", "date"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "processor.3.status_remapper.0.sources.0", "status"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "processor.4.category_processor.0.category.#", "2"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "processor.4.category_processor.0.category.1.filter.0.query", "@http.status_code:[400 TO 499]"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "processor.5.arithmetic_processor.0.expression", "(time_elapsed - time_queued) * 1000"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "processor.6.string_builder_processor.0.template", "%{user.name} logged in"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "processor.7.url_parser.0.target", "http.url_details"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "processor.8.user_agent_parser.0.is_encoded", "false"),
+				),
+			},
+			{
+				Config: testAccCheckDatadogLogsPipelineConfigUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogLogsPipelineExists("datadog_logs_pipeline.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "name", "my updated pipeline"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "is_enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "processor.#", "2"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "processor.0.status_remapper.0.sources.#", "2"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline.foo", "processor.1.grok_parser.0.grok.0.support_rules", "_date %{date(\"yyyy-MM-dd\"):date}"),
+					testAccCheckDatadogLogsPipelineRejectsUnsupportedProcessors("datadog_logs_pipeline.foo"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDatadogLogsPipelineRejectsUnsupportedProcessors adds a nested
+// pipeline to the pipeline, as if it was done outside of Terraform, and checks
+// that reading it fails instead of ignoring the processor, which the next
+// update would delete.
+func testAccCheckDatadogLogsPipelineRejectsUnsupportedProcessors(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*datadog.Client)
+		id := s.RootModule().Resources[n].Primary.ID
+		path := "/v1/logs/config/pipelines/" + id
+
+		var pipeline map[string]interface{}
+		if err := doDatadogRequest(client, "GET", path, nil, nil, &pipeline); err != nil {
+			return fmt.Errorf("Received an error retrieving logs pipeline %s", err)
+		}
+		processors, _ := pipeline["processors"].([]interface{})
+		pipeline["processors"] = append(processors, map[string]interface{}{
+			"type":       "pipeline",
+			"name":       "nested",
+			"is_enabled": true,
+			"filter":     map[string]interface{}{"query": "service:foo"},
+			"processors": []interface{}{},
+		})
+		if err := doDatadogRequest(client, "PUT", path, nil, pipeline, nil); err != nil {
+			return fmt.Errorf("Received an error updating logs pipeline %s", err)
+		}
+
+		r := resourceDatadogLogsPipeline()
+		d := r.TestResourceData()
+		d.SetId(id)
+		err := r.Read(d, client)
+
+		pipeline["processors"] = processors
+		if err := doDatadogRequest(client, "PUT", path, nil, pipeline, nil); err != nil {
+			return fmt.Errorf("Received an error updating logs pipeline %s", err)
+		}
+
+		expected := fmt.Sprintf("has a pipeline processor at position %d", len(processors))
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			return fmt.Errorf("expected reading the pipeline to fail with %q, got %v", expected, err)
+		}
+		return nil
+	}
+}
+
+func TestDatadogLogsPipeline_import(t *testing.T) {
+	resourceName := "datadog_logs_pipeline.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogLogsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogLogsPipelineConfig,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDatadogLogsPipelineOrder_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogLogsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogLogsPipelineOrderConfig("first", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogLogsPipelineOrder("datadog_logs_pipeline_order.order", "first", "second"),
+					resource.TestCheckResourceAttr(
+						"datadog_logs_pipeline_order.order", "pipelines.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckDatadogLogsPipelineOrderConfig("second", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogLogsPipelineOrder("datadog_logs_pipeline_order.order", "second", "first"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogLogsPipelineExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*datadog.Client)
+		if _, err := getLogsPipeline(client, s.RootModule().Resources[n].Primary.ID); err != nil {
+			return fmt.Errorf("Received an error retrieving logs pipeline %s", err)
+		}
+		return nil
+	}
+}
+
+// testAccCheckDatadogLogsPipelineOrder checks the order of the pipelines as
+// stored by the API, given the names of the datadog_logs_pipeline resources.
+func testAccCheckDatadogLogsPipelineOrder(n string, pipelines ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*datadog.Client)
+		order, err := getLogsPipelineOrder(client)
+		if err != nil {
+			return fmt.Errorf("Received an error retrieving logs pipeline order %s", err)
+		}
+		if len(order.PipelineIDs) != len(pipelines) {
+			return fmt.Errorf("the pipeline order has %d pipelines, expected %d", len(order.PipelineIDs), len(pipelines))
+		}
+		for i, p := range pipelines {
+			id := s.RootModule().Resources["datadog_logs_pipeline."+p].Primary.ID
+			if order.PipelineIDs[i] != id {
+				return fmt.Errorf("pipeline %d is %s, expected %s (%s)", i, order.PipelineIDs[i], id, p)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogLogsPipelineDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*datadog.Client)
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "datadog_logs_pipeline" {
+			continue
+		}
+		if _, err := getLogsPipeline(client, r.Primary.ID); err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return fmt.Errorf("Received an error retrieving logs pipeline %s", err)
+		}
+		return fmt.Errorf("Logs pipeline still exists")
+	}
+	return nil
+}
+
+const testAccCheckDatadogLogsPipelineConfig = `
+resource "datadog_logs_pipeline" "foo" {
+  name       = "my pipeline"
+  is_enabled = true
+
+  filter {
+    query = "source:foo"
+  }
+
+  processor {
+    grok_parser {
+      name       = "Parse the message"
+      is_enabled = true
+      source     = "message"
+      samples    = ["2019-03-05 10:00:00 INFO user logged in"]
+
+      grok {
+        match_rules = "rule %{date(\"yyyy-MM-dd HH:mm:ss\"):date} %{word:status} %{data:msg}"
+      }
+    }
+  }
+
+  processor {
+    attribute_remapper {
+      name            = "Remap the env tag"
+      is_enabled      = true
+      sources         = ["env"]
+      source_type     = "tag"
+      target          = "environment"
+      preserve_source = true
+    }
+  }
+
+  processor {
+    date_remapper {
+      is_enabled = true
+      sources    = ["date"]
+    }
+  }
+
+  processor {
+    status_remapper {
+      is_enabled = true
+      sources    = ["status"]
+    }
+  }
+
+  processor {
+    category_processor {
+      name       = "Categorize status codes"
+      is_enabled = true
+      target     = "http.status_category"
+
+      category {
+        name = "OK"
+
+        filter {
+          query = "@http.status_code:[200 TO 299]"
+        }
+      }
+
+      category {
+        name = "Client error"
+
+        filter {
+          query = "@http.status_code:[400 TO 499]"
+        }
+      }
+    }
+  }
+
+  processor {
+    arithmetic_processor {
+      is_enabled         = true
+      expression         = "(time_elapsed - time_queued) * 1000"
+      target             = "duration"
+      is_replace_missing = true
+    }
+  }
+
+  processor {
+    string_builder_processor {
+      is_enabled = true
+      template   = "%{user.name} logged in"
+      target     = "summary"
+    }
+  }
+
+  processor {
+    url_parser {
+      is_enabled               = true
+      sources                  = ["http.url"]
+      target                   = "http.url_details"
+      normalize_ending_slashes = true
+    }
+  }
+
+  processor {
+    user_agent_parser {
+      is_enabled = true
+      sources    = ["http.useragent"]
+      target     = "http.useragent_details"
+    }
+  }
+}
+`
+
+const testAccCheckDatadogLogsPipelineConfigUpdated = `
+resource "datadog_logs_pipeline" "foo" {
+  name = "my updated pipeline"
+
+  filter {
+    query = "source:foo"
+  }
+
+  processor {
+    status_remapper {
+      is_enabled = true
+      sources    = ["status", "level"]
+    }
+  }
+
+  processor {
+    grok_parser {
+      is_enabled = true
+      source     = "message"
+
+      grok {
+        support_rules = "_date %{date(\"yyyy-MM-dd\"):date}"
+        match_rules   = "rule %{_date} %{data:msg}"
+      }
+    }
+  }
+}
+`
+
+const testAccCheckDatadogLogsPipelineConfigTwoProcessors = `
+resource "datadog_logs_pipeline" "foo" {
+  name = "my pipeline"
+
+  filter {
+    query = "source:foo"
+  }
+
+  processor {
+    date_remapper {
+      sources = ["date"]
+    }
+
+    status_remapper {
+      sources = ["status"]
+    }
+  }
+}
+`
+
+func testAccCheckDatadogLogsPipelineOrderConfig(pipelines ...string) string {
+	return fmt.Sprintf(`
+resource "datadog_logs_pipeline" "first" {
+  name = "first pipeline"
+
+  filter {
+    query = "source:first"
+  }
+}
+
+resource "datadog_logs_pipeline" "second" {
+  name = "second pipeline"
+
+  filter {
+    query = "source:second"
+  }
+}
+
+resource "datadog_logs_pipeline_order" "order" {
+  name      = "order"
+  pipelines = ["${datadog_logs_pipeline.%s.id}", "${datadog_logs_pipeline.%s.id}"]
+}
+`, pipelines[0], pipelines[1])
+}
@@ -2,7 +2,7 @@ package datadog
 
 import (
 	"fmt"
-	"strings"
+	"log"
 
 	"github.com/zorkian/go-datadog-api"
 
@@ -72,8 +72,7 @@ func resourceDatadogMetricMetadataExists(d *schema.ResourceData, meta interface{
 	id, _ := buildMetricMetadataStruct(d)
 
 	if _, err := client.ViewMetricMetadata(id); err != nil {
-		fmt.Println("view:", err)
-		if strings.Contains(err.Error(), "404 Not Found") {
+		if isNotFoundError(err) {
 			return false, nil
 		}
 		return false, err
@@ -104,6 +103,11 @@ func resourceDatadogMetricMetadataRead(d *schema.ResourceData, meta interface{})
 
 	m, err := client.ViewMetricMetadata(id)
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] metadata of metric %s not found, removing it from state", id)
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
 
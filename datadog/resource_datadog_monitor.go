@@ -21,6 +21,7 @@ func resourceDatadogMonitor() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceDatadogMonitorImport,
 		},
+		CustomizeDiff: resourceDatadogMonitorCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -95,6 +96,22 @@ func resourceDatadogMonitor() *schema.Resource {
 				},
 				DiffSuppressFunc: suppressDataDogFloatIntDiff,
 			},
+			"threshold_windows": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recovery_window": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"trigger_window": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"notify_no_data": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -183,6 +200,17 @@ func buildMonitorStruct(d *schema.ResourceData) *datadog.Monitor {
 		RequireFullWindow: datadog.Bool(d.Get("require_full_window").(bool)),
 		IncludeTags:       datadog.Bool(d.Get("include_tags").(bool)),
 	}
+	if _, ok := d.GetOk("threshold_windows"); ok {
+		var thresholdWindows datadog.ThresholdWindows
+
+		if r, ok := d.GetOk("threshold_windows.recovery_window"); ok {
+			thresholdWindows.SetRecoveryWindow(r.(string))
+		}
+		if r, ok := d.GetOk("threshold_windows.trigger_window"); ok {
+			thresholdWindows.SetTriggerWindow(r.(string))
+		}
+		o.ThresholdWindows = &thresholdWindows
+	}
 	if attr, ok := d.GetOk("silenced"); ok {
 		s := make(map[string]int)
 		// TODO: this is not very defensive, test if we can fail on non int input
@@ -249,7 +277,7 @@ func resourceDatadogMonitorExists(d *schema.ResourceData, meta interface{}) (b b
 	}
 
 	if _, err = client.GetMonitor(i); err != nil {
-		if strings.Contains(err.Error(), "404 Not Found") {
+		if isNotFoundError(err) {
 			return false, nil
 		}
 		return false, err
@@ -283,9 +311,22 @@ func resourceDatadogMonitorRead(d *schema.ResourceData, meta interface{}) error
 
 	m, err := client.GetMonitor(i)
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] monitor %d not found, removing it from state", i)
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
 
+	updateDatadogMonitorState(d, m)
+
+	return nil
+}
+
+// updateDatadogMonitorState flattens a monitor returned by the API into d. It is
+// shared by the datadog_monitor resource and data source.
+func updateDatadogMonitorState(d *schema.ResourceData, m *datadog.Monitor) {
 	thresholds := make(map[string]string)
 	for k, v := range map[string]json.Number{
 		"ok":                m.Options.Thresholds.GetOk(),
@@ -301,6 +342,16 @@ func resourceDatadogMonitorRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	thresholdWindows := make(map[string]string)
+	for k, v := range map[string]string{
+		"recovery_window": m.Options.ThresholdWindows.GetRecoveryWindow(),
+		"trigger_window":  m.Options.ThresholdWindows.GetTriggerWindow(),
+	} {
+		if v != "" {
+			thresholdWindows[k] = v
+		}
+	}
+
 	tags := []string{}
 	for _, s := range m.Tags {
 		tags = append(tags, s)
@@ -312,6 +363,7 @@ func resourceDatadogMonitorRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("query", m.GetQuery())
 	d.Set("type", m.GetType())
 	d.Set("thresholds", thresholds)
+	d.Set("threshold_windows", thresholdWindows)
 
 	d.Set("new_host_delay", m.Options.GetNewHostDelay())
 	d.Set("evaluation_delay", m.Options.GetEvaluationDelay())
@@ -326,8 +378,6 @@ func resourceDatadogMonitorRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("tags", tags)
 	d.Set("require_full_window", m.Options.GetRequireFullWindow()) // TODO Is this one of those options that we neeed to check?
 	d.Set("locked", m.Options.GetLocked())
-
-	return nil
 }
 
 func resourceDatadogMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -387,6 +437,17 @@ func resourceDatadogMonitorUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if attr, ok := d.GetOk("threshold_windows"); ok {
+		thresholdWindows := attr.(map[string]interface{})
+		o.ThresholdWindows = &datadog.ThresholdWindows{}
+		if thresholdWindows["recovery_window"] != nil {
+			o.ThresholdWindows.SetRecoveryWindow(thresholdWindows["recovery_window"].(string))
+		}
+		if thresholdWindows["trigger_window"] != nil {
+			o.ThresholdWindows.SetTriggerWindow(thresholdWindows["trigger_window"].(string))
+		}
+	}
+
 	newHostDelay := d.Get("new_host_delay")
 	o.SetNewHostDelay(newHostDelay.(int))
 
@@ -424,7 +485,12 @@ func resourceDatadogMonitorUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	m.Options = &o
 
-	if err = client.UpdateMonitor(m); err != nil {
+	if o.ThresholdWindows == nil && d.HasChange("threshold_windows") {
+		err = updateMonitorWithoutThresholdWindows(client, m)
+	} else {
+		err = client.UpdateMonitor(m)
+	}
+	if err != nil {
 		return fmt.Errorf("error updating monitor: %s", err.Error())
 	}
 
@@ -443,6 +509,27 @@ func resourceDatadogMonitorUpdate(d *schema.ResourceData, meta interface{}) erro
 	return retval
 }
 
+// updateMonitorWithoutThresholdWindows updates a monitor and removes its
+// threshold windows. Updates leaving them out keep the current ones, and
+// go-datadog-api can't send the null windows the API removes.
+func updateMonitorWithoutThresholdWindows(client *datadog.Client, m *datadog.Monitor) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	var monitor map[string]interface{}
+	if err := json.Unmarshal(b, &monitor); err != nil {
+		return err
+	}
+	options, _ := monitor["options"].(map[string]interface{})
+	if options == nil {
+		options = map[string]interface{}{}
+		monitor["options"] = options
+	}
+	options["threshold_windows"] = map[string]interface{}{"recovery_window": nil, "trigger_window": nil}
+	return doDatadogRequest(client, "PUT", fmt.Sprintf("/v1/monitor/%d", m.GetId()), nil, monitor, nil)
+}
+
 func resourceDatadogMonitorDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*datadog.Client)
 
@@ -458,6 +545,31 @@ func resourceDatadogMonitorDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// resourceDatadogMonitorCustomizeDiff checks threshold windows are set on the
+// monitors based on an anomaly query, and only on those, as the API requires.
+func resourceDatadogMonitorCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("query") || !diff.NewValueKnown("threshold_windows") {
+		return nil
+	}
+	query := diff.Get("query").(string)
+	isAnomaly := strings.Contains(query, "anomalies(")
+
+	attr, ok := diff.GetOk("threshold_windows")
+	if !isAnomaly {
+		if ok {
+			return fmt.Errorf("threshold_windows can only be used with anomaly monitors, the query %q does not call anomalies()", strings.TrimSpace(query))
+		}
+		return nil
+	}
+	thresholdWindows, _ := attr.(map[string]interface{})
+	for _, k := range []string{"recovery_window", "trigger_window"} {
+		if v, _ := thresholdWindows[k].(string); v == "" {
+			return fmt.Errorf("threshold_windows.%s is required for anomaly monitors", k)
+		}
+	}
+	return nil
+}
+
 func resourceDatadogMonitorImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	if err := resourceDatadogMonitorRead(d, meta); err != nil {
 		return nil, err
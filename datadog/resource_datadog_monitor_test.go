@@ -2,6 +2,7 @@ package datadog
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -468,6 +469,73 @@ func TestAccDatadogMonitor_Basic_float_int(t *testing.T) {
 	})
 }
 
+func TestAccDatadogMonitor_ThresholdWindows(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDatadogMonitorConfigThresholdWindowsNoAnomaly,
+				ExpectError: regexp.MustCompile("threshold_windows can only be used with anomaly monitors"),
+			},
+			{
+				Config:      testAccCheckDatadogMonitorConfigThresholdWindowsMissing,
+				ExpectError: regexp.MustCompile("threshold_windows.recovery_window is required for anomaly monitors"),
+			},
+			{
+				Config: testAccCheckDatadogMonitorConfigThresholdWindows,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogMonitorExists("datadog_monitor.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_monitor.foo", "threshold_windows.%", "2"),
+					resource.TestCheckResourceAttr(
+						"datadog_monitor.foo", "threshold_windows.recovery_window", "last_15m"),
+					resource.TestCheckResourceAttr(
+						"datadog_monitor.foo", "threshold_windows.trigger_window", "last_15m"),
+				),
+			},
+			{
+				Config: testAccCheckDatadogMonitorConfigThresholdWindowsUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogMonitorExists("datadog_monitor.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_monitor.foo", "threshold_windows.%", "2"),
+					resource.TestCheckResourceAttr(
+						"datadog_monitor.foo", "threshold_windows.recovery_window", "last_30m"),
+					resource.TestCheckResourceAttr(
+						"datadog_monitor.foo", "threshold_windows.trigger_window", "last_5m"),
+				),
+			},
+			{
+				// Windows are removed along with the anomaly query.
+				Config: testAccCheckDatadogMonitorConfigThresholdWindowsRemoved,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogMonitorExists("datadog_monitor.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_monitor.foo", "threshold_windows.%", "0"),
+					testAccCheckDatadogMonitorHasNoThresholdWindows("datadog_monitor.foo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogMonitorHasNoThresholdWindows(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*datadog.Client)
+		i, _ := strconv.Atoi(s.RootModule().Resources[n].Primary.ID)
+		m, err := client.GetMonitor(i)
+		if err != nil {
+			return fmt.Errorf("Received an error retrieving monitor %s", err)
+		}
+		if m.Options.ThresholdWindows != nil {
+			return fmt.Errorf("Monitor still has threshold windows %+v", *m.Options.ThresholdWindows)
+		}
+		return nil
+	}
+}
+
 func testAccCheckDatadogMonitorDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*datadog.Client)
 
@@ -742,6 +810,98 @@ EOF
 }
 `
 
+const testAccCheckDatadogMonitorConfigThresholdWindows = `
+resource "datadog_monitor" "foo" {
+  name    = "name for anomaly monitor foo"
+  type    = "query alert"
+  message = "some message Notify: @hipchat-channel"
+
+  query = "avg(last_1h):anomalies(avg:system.cpu.system{name:cassandra}, 'basic', 3, direction='above', alert_window='last_15m', interval=60, count_default_zero='true') >= 1"
+
+  thresholds {
+	critical          = 1.0
+	critical_recovery = 0.0
+  }
+
+  threshold_windows {
+	recovery_window = "last_15m"
+	trigger_window  = "last_15m"
+  }
+
+  notify_no_data    = false
+  renotify_interval = 60
+}
+`
+
+const testAccCheckDatadogMonitorConfigThresholdWindowsUpdated = `
+resource "datadog_monitor" "foo" {
+  name    = "name for anomaly monitor foo"
+  type    = "query alert"
+  message = "some message Notify: @hipchat-channel"
+
+  query = "avg(last_1h):anomalies(avg:system.cpu.system{name:cassandra}, 'basic', 3, direction='above', alert_window='last_15m', interval=60, count_default_zero='true') >= 1"
+
+  thresholds {
+	critical          = 1.0
+	critical_recovery = 0.0
+  }
+
+  threshold_windows {
+	recovery_window = "last_30m"
+	trigger_window  = "last_5m"
+  }
+
+  notify_no_data    = false
+  renotify_interval = 60
+}
+`
+
+const testAccCheckDatadogMonitorConfigThresholdWindowsNoAnomaly = `
+resource "datadog_monitor" "foo" {
+  name    = "name for monitor foo"
+  type    = "query alert"
+  message = "some message Notify: @hipchat-channel"
+
+  query = "avg(last_1h):avg:aws.ec2.cpu{environment:foo,host:foo} by {host} > 2"
+
+  threshold_windows {
+	recovery_window = "last_15m"
+	trigger_window  = "last_15m"
+  }
+}
+`
+
+const testAccCheckDatadogMonitorConfigThresholdWindowsMissing = `
+resource "datadog_monitor" "foo" {
+  name    = "name for anomaly monitor foo"
+  type    = "query alert"
+  message = "some message Notify: @hipchat-channel"
+
+  query = "avg(last_1h):anomalies(avg:system.cpu.system{name:cassandra}, 'basic', 3, direction='above', alert_window='last_15m', interval=60, count_default_zero='true') >= 1"
+
+  threshold_windows {
+	trigger_window = "last_15m"
+  }
+}
+`
+
+const testAccCheckDatadogMonitorConfigThresholdWindowsRemoved = `
+resource "datadog_monitor" "foo" {
+  name    = "name for anomaly monitor foo"
+  type    = "query alert"
+  message = "some message Notify: @hipchat-channel"
+
+  query = "avg(last_1h):avg:system.cpu.system{name:cassandra} >= 1"
+
+  thresholds {
+	critical = 1.0
+  }
+
+  notify_no_data    = false
+  renotify_interval = 60
+}
+`
+
 func destroyHelper(s *terraform.State, client *datadog.Client) error {
 	for _, r := range s.RootModule().Resources {
 		i, _ := strconv.Atoi(r.Primary.ID)
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/kr/pretty"
@@ -1384,6 +1383,11 @@ func resourceDatadogScreenboardRead(d *schema.ResourceData, meta interface{}) er
 	}
 	screenboard, err := meta.(*datadog.Client).GetScreenboard(id)
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] screenboard %d not found, removing it from state", id)
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
 	log.Printf("[DataDog] screenboard: %v", pretty.Sprint(screenboard))
@@ -1468,7 +1472,7 @@ func resourceDatadogScreenboardExists(d *schema.ResourceData, meta interface{})
 		return false, err
 	}
 	if _, err = meta.(*datadog.Client).GetScreenboard(id); err != nil {
-		if strings.Contains(err.Error(), "404 Not Found") {
+		if isNotFoundError(err) {
 			return false, nil
 		}
 		return false, err
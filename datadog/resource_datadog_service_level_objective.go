@@ -0,0 +1,284 @@
+package datadog
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/zorkian/go-datadog-api"
+)
+
+func resourceDatadogServiceLevelObjective() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatadogServiceLevelObjectiveCreate,
+		Read:   resourceDatadogServiceLevelObjectiveRead,
+		Update: resourceDatadogServiceLevelObjectiveUpdate,
+		Delete: resourceDatadogServiceLevelObjectiveDelete,
+		Exists: resourceDatadogServiceLevelObjectiveExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceDatadogServiceLevelObjectiveCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				StateFunc: func(val interface{}) string {
+					return strings.TrimSpace(val.(string))
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"monitor", "metric"}, false),
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"thresholds": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timeframe": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"7d", "30d", "90d"}, false),
+						},
+						"target": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validateServiceLevelObjectiveTarget,
+						},
+						"warning": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validateServiceLevelObjectiveTarget,
+						},
+					},
+				},
+			},
+
+			// Monitor based SLOs
+			"monitor_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeInt},
+				ConflictsWith: []string{"query"},
+			},
+
+			// Metric based SLOs
+			"query": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"monitor_ids"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"numerator": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"denominator": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceDatadogServiceLevelObjectiveCustomizeDiff checks that the SLO has
+// what its type needs: monitors for monitor SLOs and a query for metric SLOs.
+func resourceDatadogServiceLevelObjectiveCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	seen := map[string]bool{}
+	for _, tInterface := range diff.Get("thresholds").([]interface{}) {
+		t := tInterface.(map[string]interface{})
+		timeframe := t["timeframe"].(string)
+		if seen[timeframe] {
+			return fmt.Errorf("thresholds can only have one %s timeframe", timeframe)
+		}
+		seen[timeframe] = true
+	}
+
+	switch diff.Get("type").(string) {
+	case "monitor":
+		// Monitors created in the same apply are not known yet.
+		if !diff.NewValueKnown("monitor_ids.#") {
+			return nil
+		}
+		if _, ok := diff.GetOk("monitor_ids"); !ok {
+			return fmt.Errorf("monitor_ids is required for monitor service level objectives")
+		}
+	case "metric":
+		if _, ok := diff.GetOk("query"); !ok {
+			return fmt.Errorf("query is required for metric service level objectives")
+		}
+	}
+	return nil
+}
+
+func buildServiceLevelObjectiveStruct(d *schema.ResourceData) *serviceLevelObjective {
+	slo := &serviceLevelObjective{
+		Name:        d.Get("name").(string),
+		Description: strings.TrimSpace(d.Get("description").(string)),
+		Type:        d.Get("type").(string),
+		Tags:        []string{},
+		Thresholds:  []serviceLevelObjectiveThreshold{},
+	}
+
+	for _, s := range d.Get("tags").([]interface{}) {
+		slo.Tags = append(slo.Tags, s.(string))
+	}
+
+	for _, tInterface := range d.Get("thresholds").([]interface{}) {
+		t := tInterface.(map[string]interface{})
+		threshold := serviceLevelObjectiveThreshold{
+			Timeframe: t["timeframe"].(string),
+			Target:    t["target"].(float64),
+		}
+		if warning, ok := t["warning"].(float64); ok && warning != 0 {
+			threshold.Warning = &warning
+		}
+		slo.Thresholds = append(slo.Thresholds, threshold)
+	}
+
+	switch slo.Type {
+	case "monitor":
+		for _, id := range d.Get("monitor_ids").(*schema.Set).List() {
+			slo.MonitorIDs = append(slo.MonitorIDs, id.(int))
+		}
+	case "metric":
+		if attr, ok := d.GetOk("query.0"); ok {
+			q := attr.(map[string]interface{})
+			slo.Query = &serviceLevelObjectiveQuery{
+				Numerator:   q["numerator"].(string),
+				Denominator: q["denominator"].(string),
+			}
+		}
+	}
+
+	return slo
+}
+
+func resourceDatadogServiceLevelObjectiveCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	slo, err := createServiceLevelObjective(client, buildServiceLevelObjectiveStruct(d))
+	if err != nil {
+		return fmt.Errorf("error creating service level objective: %s", err.Error())
+	}
+
+	d.SetId(slo.ID)
+
+	return nil
+}
+
+func resourceDatadogServiceLevelObjectiveRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	slo, err := getServiceLevelObjective(client, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] service level objective %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	thresholds := []map[string]interface{}{}
+	for _, t := range slo.Thresholds {
+		threshold := map[string]interface{}{
+			"timeframe": t.Timeframe,
+			"target":    t.Target,
+		}
+		if t.Warning != nil {
+			threshold["warning"] = *t.Warning
+		}
+		thresholds = append(thresholds, threshold)
+	}
+
+	tags := []string{}
+	for _, s := range slo.Tags {
+		tags = append(tags, s)
+	}
+
+	log.Printf("[DEBUG] service level objective: %+v", slo)
+	d.Set("name", slo.Name)
+	d.Set("description", slo.Description)
+	d.Set("type", slo.Type)
+	d.Set("tags", tags)
+	if err := d.Set("thresholds", thresholds); err != nil {
+		return err
+	}
+
+	switch slo.Type {
+	case "monitor":
+		d.Set("monitor_ids", slo.MonitorIDs)
+	case "metric":
+		query := []map[string]interface{}{}
+		if slo.Query != nil {
+			query = append(query, map[string]interface{}{
+				"numerator":   slo.Query.Numerator,
+				"denominator": slo.Query.Denominator,
+			})
+		}
+		d.Set("query", query)
+	}
+
+	return nil
+}
+
+func resourceDatadogServiceLevelObjectiveUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	if err := updateServiceLevelObjective(client, d.Id(), buildServiceLevelObjectiveStruct(d)); err != nil {
+		return fmt.Errorf("error updating service level objective: %s", err.Error())
+	}
+
+	return resourceDatadogServiceLevelObjectiveRead(d, meta)
+}
+
+func resourceDatadogServiceLevelObjectiveDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	if err := deleteServiceLevelObjective(client, d.Id()); err != nil {
+		return fmt.Errorf("error deleting service level objective: %s", err.Error())
+	}
+
+	return nil
+}
+
+func resourceDatadogServiceLevelObjectiveExists(d *schema.ResourceData, meta interface{}) (b bool, e error) {
+	client := meta.(*datadog.Client)
+
+	if _, err := getServiceLevelObjective(client, d.Id()); err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func validateServiceLevelObjectiveTarget(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(float64)
+	if value <= 0 || value >= 100 {
+		errors = append(errors, fmt.Errorf("%q must be between 0 and 100 exclusive, got %v", k, value))
+	}
+	return
+}
@@ -0,0 +1,251 @@
+package datadog
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	datadog "github.com/zorkian/go-datadog-api"
+)
+
+func TestAccDatadogServiceLevelObjective_Monitor(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogServiceLevelObjectiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDatadogServiceLevelObjectiveConfigNoMonitors,
+				ExpectError: regexp.MustCompile("monitor_ids is required for monitor service level objectives"),
+			},
+			{
+				Config: testAccCheckDatadogServiceLevelObjectiveMonitorConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogServiceLevelObjectiveExists("datadog_service_level_objective.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_service_level_objective.foo", "name", "name for monitor slo foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_service_level_objective.foo", "description", "some description"),
+					resource.TestCheckResourceAttr(
+						"datadog_service_level_objective.foo", "type", "monitor"),
+					resource.TestCheckResourceAttr(
+						"datadog_service_level_objective.foo", "monitor_ids.#", "1"),
+					resource.TestCheckResourceAttr(
+						"datadog_service_level_objective.foo", "thresholds.#", "2"),
+					resource.TestCheckResourceAttr(
+						"datadog_service_level_objective.foo", "thresholds.0.timeframe", "7d"),
+					resource.TestCheckResourceAttr(
+						"datadog_service_level_objective.foo", "thresholds.0.target", "99.5"),
+					resource.TestCheckResourceAttr(
+						"datadog_service_level_objective.foo", "thresholds.0.warning", "99.8"),
+					resource.TestCheckResourceAttr(
+						"datadog_service_level_objective.foo", "thresholds.1.timeframe", "30d"),
+					resource.TestCheckResourceAttr(
+						"datadog_service_level_objective.foo", "tags.#", "2"),
+				),
+			},
+			{
+				// Changes made outside of Terraform show up in the next plan.
+				Config: testAccCheckDatadogServiceLevelObjectiveMonitorConfig,
+				Check: func(s *terraform.State) error {
+					client := testAccProvider.Meta().(*datadog.Client)
+					id := s.RootModule().Resources["datadog_service_level_objective.foo"].Primary.ID
+					slo, err := getServiceLevelObjective(client, id)
+					if err != nil {
+						return err
+					}
+					slo.Name = "renamed in the UI"
+					return updateServiceLevelObjective(client, id, slo)
+				},
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckDatadogServiceLevelObjectiveMonitorConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"datadog_service_level_objective.foo", "name", "name for monitor slo foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatadogServiceLevelObjective_Metric(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogServiceLevelObjectiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogServiceLevelObjectiveMetricConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogServiceLevelObjectiveExists("datadog_service_level_objective.bar"),
+					resource.TestCheckResourceAttr(
+						"datadog_service_level_objective.bar", "type", "metric"),
+					resource.TestCheckResourceAttr(
+						"datadog_service_level_objective.bar", "query.0.numerator", "sum:my.custom.count.metric{type:good}.as_count()"),
+					resource.TestCheckResourceAttr(
+						"datadog_service_level_objective.bar", "query.0.denominator", "sum:my.custom.count.metric{*}.as_count()"),
+					resource.TestCheckResourceAttr(
+						"datadog_service_level_objective.bar", "thresholds.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckDatadogServiceLevelObjectiveMetricConfigUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogServiceLevelObjectiveExists("datadog_service_level_objective.bar"),
+					resource.TestCheckResourceAttr(
+						"datadog_service_level_objective.bar", "name", "updated name for metric slo bar"),
+					resource.TestCheckResourceAttr(
+						"datadog_service_level_objective.bar", "query.0.numerator", "sum:my.custom.count.metric{type:good,env:prod}.as_count()"),
+					resource.TestCheckResourceAttr(
+						"datadog_service_level_objective.bar", "thresholds.#", "3"),
+					resource.TestCheckResourceAttr(
+						"datadog_service_level_objective.bar", "thresholds.2.timeframe", "90d"),
+				),
+			},
+		},
+	})
+}
+
+func TestDatadogServiceLevelObjective_import(t *testing.T) {
+	resourceName := "datadog_service_level_objective.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogServiceLevelObjectiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogServiceLevelObjectiveMonitorConfig,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogServiceLevelObjectiveExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*datadog.Client)
+		if _, err := getServiceLevelObjective(client, s.RootModule().Resources[n].Primary.ID); err != nil {
+			return fmt.Errorf("Received an error retrieving service level objective %s", err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogServiceLevelObjectiveDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*datadog.Client)
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "datadog_service_level_objective" {
+			continue
+		}
+		if _, err := getServiceLevelObjective(client, r.Primary.ID); err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return fmt.Errorf("Received an error retrieving service level objective %s", err)
+		}
+		return fmt.Errorf("Service level objective still exists")
+	}
+	return nil
+}
+
+const testAccCheckDatadogServiceLevelObjectiveMonitorConfig = `
+resource "datadog_monitor" "foo" {
+  name    = "monitor for slo foo"
+  type    = "query alert"
+  message = "some message Notify: @hipchat-channel"
+
+  query = "avg(last_1h):avg:aws.ec2.cpu{environment:foo,host:foo} by {host} > 2"
+
+  thresholds {
+	critical = 2
+  }
+}
+
+resource "datadog_service_level_objective" "foo" {
+  name        = "name for monitor slo foo"
+  type        = "monitor"
+  description = <<EOF
+some description
+EOF
+
+  monitor_ids = ["${datadog_monitor.foo.id}"]
+
+  thresholds {
+    timeframe = "7d"
+    target    = 99.5
+    warning   = 99.8
+  }
+
+  thresholds {
+    timeframe = "30d"
+    target    = 99
+  }
+
+  tags = ["foo:bar", "baz"]
+}
+`
+
+const testAccCheckDatadogServiceLevelObjectiveConfigNoMonitors = `
+resource "datadog_service_level_objective" "foo" {
+  name = "name for monitor slo foo"
+  type = "monitor"
+
+  thresholds {
+    timeframe = "7d"
+    target    = 99.5
+  }
+}
+`
+
+const testAccCheckDatadogServiceLevelObjectiveMetricConfig = `
+resource "datadog_service_level_objective" "bar" {
+  name = "name for metric slo bar"
+  type = "metric"
+
+  query {
+    numerator   = "sum:my.custom.count.metric{type:good}.as_count()"
+    denominator = "sum:my.custom.count.metric{*}.as_count()"
+  }
+
+  thresholds {
+    timeframe = "7d"
+    target    = 99.9
+  }
+}
+`
+
+const testAccCheckDatadogServiceLevelObjectiveMetricConfigUpdated = `
+resource "datadog_service_level_objective" "bar" {
+  name = "updated name for metric slo bar"
+  type = "metric"
+
+  query {
+    numerator   = "sum:my.custom.count.metric{type:good,env:prod}.as_count()"
+    denominator = "sum:my.custom.count.metric{env:prod}.as_count()"
+  }
+
+  thresholds {
+    timeframe = "7d"
+    target    = 99.9
+  }
+
+  thresholds {
+    timeframe = "30d"
+    target    = 99.5
+  }
+
+  thresholds {
+    timeframe = "90d"
+    target    = 99
+  }
+}
+`
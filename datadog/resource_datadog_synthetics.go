@@ -0,0 +1,356 @@
+package datadog
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/zorkian/go-datadog-api"
+)
+
+func resourceDatadogSyntheticsTest() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatadogSyntheticsTestCreate,
+		Read:   resourceDatadogSyntheticsTestRead,
+		Update: resourceDatadogSyntheticsTestUpdate,
+		Delete: resourceDatadogSyntheticsTestDelete,
+		Exists: resourceDatadogSyntheticsTestExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceDatadogSyntheticsTestCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"api", "browser"}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Optional: true,
+				StateFunc: func(val interface{}) string {
+					return strings.TrimSpace(val.(string))
+				},
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"locations": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"live", "paused"}, false),
+			},
+
+			// Config
+			"request": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"method": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "GET",
+							ValidateFunc: validation.StringInSlice([]string{"GET", "POST", "PATCH", "PUT", "DELETE", "HEAD", "OPTIONS"}, false),
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"body": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 60),
+						},
+						"headers": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"assertion": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"statusCode", "responseTime", "header", "body"}, false),
+						},
+						"operator": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"is", "isNot", "lessThan", "contains", "doesNotContain", "matches", "doesNotMatch"}, false),
+						},
+						"property": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"target": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			// Options
+			"tick_every": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice([]int{60, 300, 900, 1800, 3600, 21600, 43200, 86400, 604800}),
+			},
+			"min_failure_duration": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"min_location_failed": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
+			"follow_redirects": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"device_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"laptop_large", "tablet", "mobile_small"}, false),
+				},
+			},
+
+			"monitor_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceDatadogSyntheticsTestCustomizeDiff checks the attributes that only
+// apply to one type of test.
+func resourceDatadogSyntheticsTestCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	switch diff.Get("type").(string) {
+	case "api":
+		if _, ok := diff.GetOk("device_ids"); ok {
+			return fmt.Errorf("device_ids can only be used with browser tests")
+		}
+		if !diff.NewValueKnown("assertion") {
+			return nil
+		}
+		if attr, ok := diff.GetOk("assertion"); !ok || len(attr.([]interface{})) == 0 {
+			return fmt.Errorf("API tests need at least one assertion")
+		}
+	case "browser":
+		if !diff.NewValueKnown("device_ids") {
+			return nil
+		}
+		if attr, ok := diff.GetOk("device_ids"); !ok || len(attr.([]interface{})) == 0 {
+			return fmt.Errorf("browser tests need at least one device in device_ids")
+		}
+	}
+	return nil
+}
+
+func buildSyntheticsTestStruct(d *schema.ResourceData) *syntheticsTest {
+	test := &syntheticsTest{
+		Name:      d.Get("name").(string),
+		Type:      d.Get("type").(string),
+		Message:   strings.TrimSpace(d.Get("message").(string)),
+		Tags:      []string{},
+		Locations: []string{},
+	}
+
+	for _, s := range d.Get("tags").([]interface{}) {
+		test.Tags = append(test.Tags, s.(string))
+	}
+	for _, s := range d.Get("locations").([]interface{}) {
+		test.Locations = append(test.Locations, s.(string))
+	}
+
+	request := d.Get("request.0").(map[string]interface{})
+	test.Config.Request = syntheticsRequest{
+		Method:  request["method"].(string),
+		URL:     request["url"].(string),
+		Body:    request["body"].(string),
+		Timeout: request["timeout"].(int),
+	}
+	if headers, ok := request["headers"].(map[string]interface{}); ok && len(headers) > 0 {
+		test.Config.Request.Headers = map[string]string{}
+		for k, v := range headers {
+			test.Config.Request.Headers[k] = v.(string)
+		}
+	}
+
+	test.Config.Assertions = []syntheticsAssertion{}
+	for _, aInterface := range d.Get("assertion").([]interface{}) {
+		a := aInterface.(map[string]interface{})
+		assertion := syntheticsAssertion{
+			Type:     a["type"].(string),
+			Operator: a["operator"].(string),
+			Property: a["property"].(string),
+			Target:   a["target"].(string),
+		}
+		// Status codes and response times are compared as numbers.
+		if assertion.Type == "statusCode" || assertion.Type == "responseTime" {
+			if target, err := strconv.Atoi(a["target"].(string)); err == nil {
+				assertion.Target = target
+			}
+		}
+		test.Config.Assertions = append(test.Config.Assertions, assertion)
+	}
+
+	test.Options = syntheticsOptions{
+		TickEvery:          d.Get("tick_every").(int),
+		MinFailureDuration: d.Get("min_failure_duration").(int),
+		MinLocationFailed:  d.Get("min_location_failed").(int),
+		FollowRedirects:    d.Get("follow_redirects").(bool),
+	}
+	for _, s := range d.Get("device_ids").([]interface{}) {
+		test.Options.DeviceIDs = append(test.Options.DeviceIDs, s.(string))
+	}
+
+	return test
+}
+
+func resourceDatadogSyntheticsTestCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	test, err := createSyntheticsTest(client, buildSyntheticsTestStruct(d))
+	if err != nil {
+		return fmt.Errorf("error creating synthetics test: %s", err.Error())
+	}
+	d.SetId(test.PublicID)
+
+	// New tests start live.
+	if status := d.Get("status").(string); status != test.Status {
+		if err := updateSyntheticsTestStatus(client, test.PublicID, status); err != nil {
+			return fmt.Errorf("error updating synthetics test status: %s", err.Error())
+		}
+	}
+
+	return resourceDatadogSyntheticsTestRead(d, meta)
+}
+
+func resourceDatadogSyntheticsTestRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	test, err := getSyntheticsTest(client, d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] synthetics test %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	request := map[string]interface{}{
+		"method":  test.Config.Request.Method,
+		"url":     test.Config.Request.URL,
+		"body":    test.Config.Request.Body,
+		"timeout": test.Config.Request.Timeout,
+		"headers": test.Config.Request.Headers,
+	}
+
+	assertions := []map[string]interface{}{}
+	for _, a := range test.Config.Assertions {
+		target := fmt.Sprint(a.Target)
+		if f, ok := a.Target.(float64); ok {
+			target = strconv.FormatFloat(f, 'f', -1, 64)
+		}
+		assertions = append(assertions, map[string]interface{}{
+			"type":     a.Type,
+			"operator": a.Operator,
+			"property": a.Property,
+			"target":   target,
+		})
+	}
+
+	d.Set("type", test.Type)
+	d.Set("name", test.Name)
+	d.Set("message", test.Message)
+	d.Set("tags", test.Tags)
+	d.Set("locations", test.Locations)
+	d.Set("status", test.Status)
+	d.Set("monitor_id", test.MonitorID)
+	if err := d.Set("request", []map[string]interface{}{request}); err != nil {
+		return err
+	}
+	if err := d.Set("assertion", assertions); err != nil {
+		return err
+	}
+	d.Set("tick_every", test.Options.TickEvery)
+	d.Set("min_failure_duration", test.Options.MinFailureDuration)
+	d.Set("min_location_failed", test.Options.MinLocationFailed)
+	d.Set("follow_redirects", test.Options.FollowRedirects)
+	d.Set("device_ids", test.Options.DeviceIDs)
+
+	return nil
+}
+
+func resourceDatadogSyntheticsTestUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	if _, err := updateSyntheticsTest(client, d.Id(), buildSyntheticsTestStruct(d)); err != nil {
+		return fmt.Errorf("error updating synthetics test: %s", err.Error())
+	}
+
+	if d.HasChange("status") {
+		if err := updateSyntheticsTestStatus(client, d.Id(), d.Get("status").(string)); err != nil {
+			return fmt.Errorf("error updating synthetics test status: %s", err.Error())
+		}
+	}
+
+	return resourceDatadogSyntheticsTestRead(d, meta)
+}
+
+func resourceDatadogSyntheticsTestDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*datadog.Client)
+
+	if err := deleteSyntheticsTests(client, d.Id()); err != nil {
+		return fmt.Errorf("error deleting synthetics test: %s", err.Error())
+	}
+
+	return nil
+}
+
+func resourceDatadogSyntheticsTestExists(d *schema.ResourceData, meta interface{}) (b bool, e error) {
+	client := meta.(*datadog.Client)
+
+	if _, err := getSyntheticsTest(client, d.Id()); err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,245 @@
+package datadog
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	datadog "github.com/zorkian/go-datadog-api"
+)
+
+func TestAccDatadogSyntheticsAPITest_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogSyntheticsTestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogSyntheticsAPITestConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogSyntheticsTestExists("datadog_synthetics_test.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "type", "api"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "name", "name for synthetics test foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "message", "Notify @datadog.user"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "status", "paused"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "request.0.method", "GET"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "request.0.url", "https://www.datadoghq.com"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "request.0.headers.Accept", "text/html"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "assertion.#", "2"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "assertion.0.type", "statusCode"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "assertion.0.target", "200"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "assertion.1.property", "content-type"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "locations.#", "2"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "tick_every", "60"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "min_failure_duration", "120"),
+					resource.TestCheckResourceAttrSet(
+						"datadog_synthetics_test.foo", "monitor_id"),
+				),
+			},
+			{
+				Config: testAccCheckDatadogSyntheticsAPITestConfigUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogSyntheticsTestExists("datadog_synthetics_test.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "name", "updated name"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "status", "live"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "assertion.#", "1"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "assertion.0.type", "responseTime"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "assertion.0.target", "2000"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.foo", "tick_every", "300"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatadogSyntheticsBrowserTest_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogSyntheticsTestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDatadogSyntheticsBrowserTestConfigNoDevice,
+				ExpectError: regexp.MustCompile("browser tests need at least one device"),
+			},
+			{
+				Config: testAccCheckDatadogSyntheticsBrowserTestConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogSyntheticsTestExists("datadog_synthetics_test.bar"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.bar", "type", "browser"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.bar", "status", "live"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.bar", "device_ids.#", "2"),
+					resource.TestCheckResourceAttr(
+						"datadog_synthetics_test.bar", "assertion.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestDatadogSyntheticsTest_import(t *testing.T) {
+	resourceName := "datadog_synthetics_test.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogSyntheticsTestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogSyntheticsAPITestConfig,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogSyntheticsTestExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*datadog.Client)
+		if _, err := getSyntheticsTest(client, s.RootModule().Resources[n].Primary.ID); err != nil {
+			return fmt.Errorf("Received an error retrieving synthetics test %s", err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogSyntheticsTestDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*datadog.Client)
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "datadog_synthetics_test" {
+			continue
+		}
+		if _, err := getSyntheticsTest(client, r.Primary.ID); err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return fmt.Errorf("Received an error retrieving synthetics test %s", err)
+		}
+		return fmt.Errorf("Synthetics test still exists")
+	}
+	return nil
+}
+
+const testAccCheckDatadogSyntheticsAPITestConfig = `
+resource "datadog_synthetics_test" "foo" {
+  type    = "api"
+  name    = "name for synthetics test foo"
+  message = <<EOF
+Notify @datadog.user
+EOF
+  tags      = ["foo:bar", "baz"]
+  locations = ["aws:eu-central-1", "aws:us-east-2"]
+  status    = "paused"
+
+  request {
+    method  = "GET"
+    url     = "https://www.datadoghq.com"
+    timeout = 30
+
+    headers {
+      Accept = "text/html"
+    }
+  }
+
+  assertion {
+    type     = "statusCode"
+    operator = "is"
+    target   = "200"
+  }
+
+  assertion {
+    type     = "header"
+    property = "content-type"
+    operator = "contains"
+    target   = "text/html"
+  }
+
+  tick_every           = 60
+  min_failure_duration = 120
+}
+`
+
+const testAccCheckDatadogSyntheticsAPITestConfigUpdated = `
+resource "datadog_synthetics_test" "foo" {
+  type      = "api"
+  name      = "updated name"
+  message   = "Notify @datadog.user"
+  tags      = ["foo:bar", "baz"]
+  locations = ["aws:eu-central-1"]
+  status    = "live"
+
+  request {
+    method = "GET"
+    url    = "https://docs.datadoghq.com"
+  }
+
+  assertion {
+    type     = "responseTime"
+    operator = "lessThan"
+    target   = "2000"
+  }
+
+  tick_every = 300
+}
+`
+
+const testAccCheckDatadogSyntheticsBrowserTestConfig = `
+resource "datadog_synthetics_test" "bar" {
+  type      = "browser"
+  name      = "name for synthetics browser test bar"
+  message   = "Notify @datadog.user"
+  locations = ["aws:eu-central-1"]
+  status    = "live"
+
+  request {
+    url = "https://app.datadoghq.com"
+  }
+
+  device_ids = ["laptop_large", "mobile_small"]
+  tick_every = 3600
+}
+`
+
+const testAccCheckDatadogSyntheticsBrowserTestConfigNoDevice = `
+resource "datadog_synthetics_test" "bar" {
+  type      = "browser"
+  name      = "name for synthetics browser test bar"
+  locations = ["aws:eu-central-1"]
+  status    = "live"
+
+  request {
+    url = "https://app.datadoghq.com"
+  }
+
+  tick_every = 3600
+}
+`
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/kr/pretty"
@@ -775,6 +774,11 @@ func resourceDatadogTimeboardRead(d *schema.ResourceData, meta interface{}) erro
 	}
 	timeboard, err := meta.(*datadog.Client).GetDashboard(id)
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] timeboard %d not found, removing it from state", id)
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
 	log.Printf("[DataDog] timeboard: %v", pretty.Sprint(timeboard))
@@ -839,7 +843,7 @@ func resourceDatadogTimeboardExists(d *schema.ResourceData, meta interface{}) (b
 		return false, err
 	}
 	if _, err = meta.(*datadog.Client).GetDashboard(id); err != nil {
-		if strings.Contains(err.Error(), "404 Not Found") {
+		if isNotFoundError(err) {
 			return false, nil
 		}
 		return false, err
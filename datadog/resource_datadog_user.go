@@ -3,9 +3,9 @@ package datadog
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/zorkian/go-datadog-api"
 )
 
@@ -19,8 +19,25 @@ func resourceDatadogUser() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceDatadogUserImport,
 		},
+		SchemaVersion: 1,
+		MigrateState:  resourceDatadogUserMigrateState,
 
 		Schema: map[string]*schema.Schema{
+			"access_role": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The role of the user: st (standard), adm (admin) or ro (read-only). New users are standard users.",
+				ValidateFunc:  validation.StringInSlice([]string{"st", "adm", "ro"}, false),
+				ConflictsWith: []string{"is_admin"},
+			},
+			"destroy_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disable",
+				Description:  "What happens to the user when the resource is destroyed: disable it, delete it, or leave it untouched with none.",
+				ValidateFunc: validation.StringInSlice([]string{"disable", "delete", "none"}, false),
+			},
 			"disabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -35,10 +52,11 @@ func resourceDatadogUser() *schema.Resource {
 				Required: true,
 			},
 			"is_admin": {
-				Type:       schema.TypeBool,
-				Optional:   true,
-				Default:    false,
-				Deprecated: "This parameter will be replaced by `access_role` and will be removed from the next Major version",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				Deprecated:    "This parameter is replaced by `access_role` and will be removed from the next Major version",
+				ConflictsWith: []string{"access_role"},
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -63,7 +81,7 @@ func resourceDatadogUserExists(d *schema.ResourceData, meta interface{}) (b bool
 	client := meta.(*datadog.Client)
 
 	if _, err := client.GetUser(d.Id()); err != nil {
-		if strings.Contains(err.Error(), "404 Not Found") {
+		if isNotFoundError(err) {
 			return false, nil
 		}
 		return false, err
@@ -79,13 +97,17 @@ func resourceDatadogUserCreate(d *schema.ResourceData, meta interface{}) error {
 	u.SetDisabled(d.Get("disabled").(bool))
 	u.SetEmail(d.Get("email").(string))
 	u.SetHandle(d.Get("handle").(string))
-	u.SetIsAdmin(d.Get("is_admin").(bool))
 	u.SetName(d.Get("name").(string))
+	if accessRole, ok := d.GetOk("access_role"); ok {
+		u.SetAccessRole(accessRole.(string))
+	} else {
+		u.SetAccessRole(accessRoleFromIsAdmin(d.Get("is_admin").(bool)))
+	}
 
-	// Datadog does not actually delete users, so CreateUser might return a 409.
+	// Disabled users are kept by Datadog, so CreateUser might return a 409.
 	// We ignore that case and proceed, likely re-enabling the user.
 	if _, err := client.CreateUser(u.Handle, u.Name); err != nil {
-		if !strings.Contains(err.Error(), "API error 409 Conflict") {
+		if !isConflictError(err) {
 			return fmt.Errorf("error creating user: %s", err.Error())
 		}
 		log.Printf("[INFO] Updating existing Datadog user %s", *u.Handle)
@@ -105,9 +127,15 @@ func resourceDatadogUserRead(d *schema.ResourceData, meta interface{}) error {
 
 	u, err := client.GetUser(d.Id())
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] user %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
 
+	d.Set("access_role", u.GetAccessRole())
 	d.Set("disabled", u.GetDisabled())
 	d.Set("email", u.GetEmail())
 	d.Set("handle", u.GetHandle())
@@ -124,8 +152,14 @@ func resourceDatadogUserUpdate(d *schema.ResourceData, meta interface{}) error {
 	u.SetDisabled(d.Get("disabled").(bool))
 	u.SetEmail(d.Get("email").(string))
 	u.SetHandle(d.Id())
-	u.SetIsAdmin(d.Get("is_admin").(bool))
 	u.SetName(d.Get("name").(string))
+	// Configurations still using the deprecated is_admin change the role
+	// through it.
+	if d.HasChange("is_admin") && !d.HasChange("access_role") {
+		u.SetAccessRole(accessRoleFromIsAdmin(d.Get("is_admin").(bool)))
+	} else {
+		u.SetAccessRole(d.Get("access_role").(string))
+	}
 
 	if err := client.UpdateUser(u); err != nil {
 		return fmt.Errorf("error updating user: %s", err.Error())
@@ -137,7 +171,27 @@ func resourceDatadogUserUpdate(d *schema.ResourceData, meta interface{}) error {
 func resourceDatadogUserDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*datadog.Client)
 
-	// Datadog does not actually delete users, but instead marks them as disabled.
+	switch d.Get("destroy_action").(string) {
+	case "none":
+		log.Printf("[INFO] Leaving Datadog user %s untouched, destroy_action is none", d.Id())
+		return nil
+	case "delete":
+		// Only the v2 API deletes users, the v1 API disables them.
+		id, err := getUserV2ID(client, d.Id())
+		if err != nil {
+			return fmt.Errorf("error deleting user: %s", err.Error())
+		}
+		if id == "" {
+			log.Printf("[WARN] Datadog user %s not found, there's nothing to delete", d.Id())
+			return nil
+		}
+		if err := deleteUserV2(client, id); err != nil && !isNotFoundError(err) {
+			return fmt.Errorf("error deleting user: %s", err.Error())
+		}
+		return nil
+	}
+
+	// DeleteUser does not actually delete users, but instead marks them as disabled.
 	// Bypass DeleteUser if GetUser returns User.Disabled == true, otherwise it will 400.
 	if u, err := client.GetUser(d.Id()); err == nil && u.GetDisabled() {
 		return nil
@@ -151,8 +205,17 @@ func resourceDatadogUserDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDatadogUserImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("destroy_action", "disable")
 	if err := resourceDatadogUserRead(d, meta); err != nil {
 		return nil, err
 	}
 	return []*schema.ResourceData{d}, nil
 }
+
+// accessRoleFromIsAdmin maps the deprecated is_admin flag to an access role.
+func accessRoleFromIsAdmin(isAdmin bool) string {
+	if isAdmin {
+		return "adm"
+	}
+	return "st"
+}
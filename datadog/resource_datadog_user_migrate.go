@@ -0,0 +1,40 @@
+package datadog
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func resourceDatadogUserMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found Datadog User State v0; migrating to v1")
+		return migrateDatadogUserStateV0toV1(is)
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+// migrateDatadogUserStateV0toV1 derives access_role from the deprecated
+// is_admin and sets the destroy_action default, which disables users like v0
+// did.
+func migrateDatadogUserStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	if is.Empty() {
+		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+		return is, nil
+	}
+
+	log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
+
+	if _, ok := is.Attributes["access_role"]; !ok {
+		is.Attributes["access_role"] = accessRoleFromIsAdmin(is.Attributes["is_admin"] == "true")
+	}
+	if _, ok := is.Attributes["destroy_action"]; !ok {
+		is.Attributes["destroy_action"] = "disable"
+	}
+
+	log.Printf("[DEBUG] Attributes after migration: %#v", is.Attributes)
+	return is, nil
+}
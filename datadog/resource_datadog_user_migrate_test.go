@@ -0,0 +1,85 @@
+package datadog
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDatadogUserMigrateState(t *testing.T) {
+	cases := map[string]struct {
+		StateVersion int
+		Attributes   map[string]string
+		Expected     map[string]string
+	}{
+		"v0_admin": {
+			StateVersion: 0,
+			Attributes: map[string]string{
+				"handle":   "admin@example.com",
+				"is_admin": "true",
+			},
+			Expected: map[string]string{
+				"access_role":    "adm",
+				"destroy_action": "disable",
+			},
+		},
+		"v0_standard": {
+			StateVersion: 0,
+			Attributes: map[string]string{
+				"handle":   "user@example.com",
+				"is_admin": "false",
+			},
+			Expected: map[string]string{
+				"access_role":    "st",
+				"destroy_action": "disable",
+			},
+		},
+		"v0_no_is_admin": {
+			StateVersion: 0,
+			Attributes: map[string]string{
+				"handle": "user@example.com",
+			},
+			Expected: map[string]string{
+				"access_role":    "st",
+				"destroy_action": "disable",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		is := &terraform.InstanceState{
+			ID:         tc.Attributes["handle"],
+			Attributes: tc.Attributes,
+		}
+		is, err := resourceDatadogUserMigrateState(tc.StateVersion, is, nil)
+		if err != nil {
+			t.Fatalf("bad: %s, err: %#v", tn, err)
+		}
+
+		for k, v := range tc.Expected {
+			if is.Attributes[k] != v {
+				t.Fatalf("bad: %s\n\n expected: %#v -> %#v\n got: %#v -> %#v\n in: %#v",
+					tn, k, v, k, is.Attributes[k], is.Attributes)
+			}
+		}
+	}
+}
+
+func TestDatadogUserMigrateState_empty(t *testing.T) {
+	var is *terraform.InstanceState
+
+	// should handle nil
+	is, err := resourceDatadogUserMigrateState(0, is, nil)
+	if err != nil {
+		t.Fatalf("err: %#v", err)
+	}
+	if is != nil {
+		t.Fatalf("expected nil instancestate, got: %#v", is)
+	}
+
+	// should handle non-nil but empty
+	is = &terraform.InstanceState{}
+	if _, err := resourceDatadogUserMigrateState(0, is, nil); err != nil {
+		t.Fatalf("err: %#v", err)
+	}
+}
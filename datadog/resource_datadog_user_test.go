@@ -2,6 +2,7 @@ package datadog
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -52,6 +53,134 @@ func TestAccDatadogUser_Updated(t *testing.T) {
 	})
 }
 
+func TestAccDatadogUser_AccessRole(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDatadogUserConfigInvalidRole,
+				ExpectError: regexp.MustCompile(`expected access_role to be one of \[st adm ro\]`),
+			},
+			{
+				Config: testAccCheckDatadogUserConfigAccessRole("ro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogUserExists("datadog_user.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_user.foo", "access_role", "ro"),
+					resource.TestCheckResourceAttr(
+						"datadog_user.foo", "is_admin", "false"),
+					resource.TestCheckResourceAttr(
+						"datadog_user.foo", "destroy_action", "disable"),
+				),
+			},
+			{
+				Config: testAccCheckDatadogUserConfigAccessRole("adm"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogUserExists("datadog_user.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_user.foo", "access_role", "adm"),
+					resource.TestCheckResourceAttr(
+						"datadog_user.foo", "is_admin", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatadogUser_DestroyActionNone(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogUserEnabled,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogUserConfigDestroyActionNone,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogUserExists("datadog_user.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_user.foo", "destroy_action", "none"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatadogUser_DestroyActionDelete(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogUserDeleted,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogUserConfigDestroyActionDelete,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogUserExists("datadog_user.foo"),
+					resource.TestCheckResourceAttr(
+						"datadog_user.foo", "destroy_action", "delete"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatadogUser_DestroyActionDeleteManyUsers(t *testing.T) {
+	if testAccFakeAPI == nil {
+		t.Skip("needs an organization with more users matching the handle than a page of the users API holds")
+	}
+	// The users whose handles contain the deleted user's come first in the
+	// results.
+	testAccFakeAPI.Lock()
+	for i := 0; i < 150; i++ {
+		handle := fmt.Sprintf("a%03d.deleted-paged@example.com", i)
+		testAccFakeAPI.users[handle] = map[string]interface{}{"handle": handle, "email": handle, "name": handle, "access_role": "st", "disabled": false}
+	}
+	testAccFakeAPI.Unlock()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatadogUserDeleted,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogUserConfigDestroyActionDeletePaged,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogUserExists("datadog_user.foo"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDatadogUserDeleted checks that destroyed users with
+// destroy_action set to delete are gone rather than disabled.
+func testAccCheckDatadogUserDeleted(s *terraform.State) error {
+	client := testAccProvider.Meta().(*datadog.Client)
+	for _, r := range s.RootModule().Resources {
+		if _, err := client.GetUser(r.Primary.ID); !isNotFoundError(err) {
+			return fmt.Errorf("User %s was not deleted: %v", r.Primary.ID, err)
+		}
+	}
+	return nil
+}
+
+// testAccCheckDatadogUserEnabled checks that destroyed users with
+// destroy_action set to none are left enabled.
+func testAccCheckDatadogUserEnabled(s *terraform.State) error {
+	client := testAccProvider.Meta().(*datadog.Client)
+	for _, r := range s.RootModule().Resources {
+		u, err := client.GetUser(r.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Received an error retrieving user %s", err)
+		}
+		if u.GetDisabled() {
+			return fmt.Errorf("User %s was disabled", r.Primary.ID)
+		}
+	}
+	return nil
+}
+
 func testAccCheckDatadogUserDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*datadog.Client)
 
@@ -89,6 +218,53 @@ resource "datadog_user" "foo" {
 }
 `
 
+const testAccCheckDatadogUserConfigInvalidRole = `
+resource "datadog_user" "foo" {
+  email       = "role@example.com"
+  handle      = "role@example.com"
+  name        = "Role User"
+  access_role = "admin"
+}
+`
+
+func testAccCheckDatadogUserConfigAccessRole(accessRole string) string {
+	return fmt.Sprintf(`
+resource "datadog_user" "foo" {
+  email       = "role@example.com"
+  handle      = "role@example.com"
+  name        = "Role User"
+  access_role = "%s"
+}
+`, accessRole)
+}
+
+const testAccCheckDatadogUserConfigDestroyActionNone = `
+resource "datadog_user" "foo" {
+  email          = "kept@example.com"
+  handle         = "kept@example.com"
+  name           = "Kept User"
+  destroy_action = "none"
+}
+`
+
+const testAccCheckDatadogUserConfigDestroyActionDelete = `
+resource "datadog_user" "foo" {
+  email          = "deleted@example.com"
+  handle         = "deleted@example.com"
+  name           = "Deleted User"
+  destroy_action = "delete"
+}
+`
+
+const testAccCheckDatadogUserConfigDestroyActionDeletePaged = `
+resource "datadog_user" "foo" {
+  email          = "deleted-paged@example.com"
+  handle         = "deleted-paged@example.com"
+  name           = "Deleted User"
+  destroy_action = "delete"
+}
+`
+
 func datadogUserDestroyHelper(s *terraform.State, client *datadog.Client) error {
 	for _, r := range s.RootModule().Resources {
 		id := r.Primary.ID
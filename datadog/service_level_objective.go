@@ -0,0 +1,67 @@
+package datadog
+
+import (
+	"fmt"
+
+	"github.com/zorkian/go-datadog-api"
+)
+
+// serviceLevelObjective is a monitor or metric based SLO.
+type serviceLevelObjective struct {
+	ID          string                           `json:"id,omitempty"`
+	Name        string                           `json:"name"`
+	Description string                           `json:"description"`
+	Type        string                           `json:"type"`
+	Tags        []string                         `json:"tags"`
+	Thresholds  []serviceLevelObjectiveThreshold `json:"thresholds"`
+	MonitorIDs  []int                            `json:"monitor_ids,omitempty"`
+	Query       *serviceLevelObjectiveQuery      `json:"query,omitempty"`
+}
+
+type serviceLevelObjectiveThreshold struct {
+	Timeframe string   `json:"timeframe"`
+	Target    float64  `json:"target"`
+	Warning   *float64 `json:"warning,omitempty"`
+}
+
+type serviceLevelObjectiveQuery struct {
+	Numerator   string `json:"numerator"`
+	Denominator string `json:"denominator"`
+}
+
+// The SLO endpoints wrap their results in a data envelope, which is a list for
+// creations and updates.
+type reqServiceLevelObjective struct {
+	Data serviceLevelObjective `json:"data"`
+}
+
+type reqServiceLevelObjectives struct {
+	Data []serviceLevelObjective `json:"data"`
+}
+
+func createServiceLevelObjective(client *datadog.Client, slo *serviceLevelObjective) (*serviceLevelObjective, error) {
+	var out reqServiceLevelObjectives
+	if err := doDatadogRequest(client, "POST", "/v1/slo", nil, slo, &out); err != nil {
+		return nil, err
+	}
+	if len(out.Data) == 0 {
+		return nil, fmt.Errorf("the API returned no service level objective")
+	}
+	return &out.Data[0], nil
+}
+
+func getServiceLevelObjective(client *datadog.Client, id string) (*serviceLevelObjective, error) {
+	var out reqServiceLevelObjective
+	if err := doDatadogRequest(client, "GET", fmt.Sprintf("/v1/slo/%s", id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out.Data, nil
+}
+
+func updateServiceLevelObjective(client *datadog.Client, id string, slo *serviceLevelObjective) error {
+	return doDatadogRequest(client, "PUT", fmt.Sprintf("/v1/slo/%s", id), nil, slo, nil)
+}
+
+func deleteServiceLevelObjective(client *datadog.Client, id string) error {
+	return doDatadogRequest(client, "DELETE", fmt.Sprintf("/v1/slo/%s", id), nil, nil, nil)
+}
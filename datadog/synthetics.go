@@ -0,0 +1,87 @@
+package datadog
+
+import (
+	"fmt"
+
+	"github.com/zorkian/go-datadog-api"
+)
+
+// syntheticsTest is a Synthetics API or browser test.
+type syntheticsTest struct {
+	PublicID  string            `json:"public_id,omitempty"`
+	MonitorID int               `json:"monitor_id,omitempty"`
+	Name      string            `json:"name"`
+	Type      string            `json:"type"`
+	Status    string            `json:"status,omitempty"`
+	Message   string            `json:"message"`
+	Tags      []string          `json:"tags"`
+	Locations []string          `json:"locations"`
+	Config    syntheticsConfig  `json:"config"`
+	Options   syntheticsOptions `json:"options"`
+}
+
+type syntheticsConfig struct {
+	Request    syntheticsRequest     `json:"request"`
+	Assertions []syntheticsAssertion `json:"assertions"`
+}
+
+type syntheticsRequest struct {
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Body    string            `json:"body,omitempty"`
+	Timeout int               `json:"timeout,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+type syntheticsAssertion struct {
+	Type     string `json:"type"`
+	Operator string `json:"operator"`
+	Property string `json:"property,omitempty"`
+	// Target is a number for status code and response time assertions and a
+	// string otherwise.
+	Target interface{} `json:"target"`
+}
+
+type syntheticsOptions struct {
+	TickEvery          int      `json:"tick_every"`
+	MinFailureDuration int      `json:"min_failure_duration"`
+	MinLocationFailed  int      `json:"min_location_failed"`
+	FollowRedirects    bool     `json:"follow_redirects"`
+	DeviceIDs          []string `json:"device_ids,omitempty"`
+}
+
+func createSyntheticsTest(client *datadog.Client, test *syntheticsTest) (*syntheticsTest, error) {
+	var out syntheticsTest
+	if err := doDatadogRequest(client, "POST", "/v1/synthetics/tests", nil, test, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func getSyntheticsTest(client *datadog.Client, publicID string) (*syntheticsTest, error) {
+	var out syntheticsTest
+	if err := doDatadogRequest(client, "GET", fmt.Sprintf("/v1/synthetics/tests/%s", publicID), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func updateSyntheticsTest(client *datadog.Client, publicID string, test *syntheticsTest) (*syntheticsTest, error) {
+	var out syntheticsTest
+	if err := doDatadogRequest(client, "PUT", fmt.Sprintf("/v1/synthetics/tests/%s", publicID), nil, test, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// updateSyntheticsTestStatus starts ("live") or pauses ("paused") a test. The
+// status can't be changed with a regular update.
+func updateSyntheticsTestStatus(client *datadog.Client, publicID, status string) error {
+	req := map[string]string{"new_status": status}
+	return doDatadogRequest(client, "PUT", fmt.Sprintf("/v1/synthetics/tests/%s/status", publicID), nil, req, nil)
+}
+
+func deleteSyntheticsTests(client *datadog.Client, publicIDs ...string) error {
+	req := map[string][]string{"public_ids": publicIDs}
+	return doDatadogRequest(client, "POST", "/v1/synthetics/tests/delete", nil, req, nil)
+}
@@ -0,0 +1,53 @@
+package datadog
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/zorkian/go-datadog-api"
+)
+
+// go-datadog-api only covers the v1 users API, whose DELETE disables users.
+// These calls cover the deletion of users through the v2 users API, which
+// identifies users by ID rather than by handle.
+
+// usersV2PageSize is the largest page of users the v2 users API returns.
+const usersV2PageSize = 100
+
+// getUserV2ID returns the v2 ID of the user with the given handle, or "" when
+// no user has that handle.
+func getUserV2ID(client *datadog.Client, handle string) (string, error) {
+	// The filter matches substrings of the handles, names and emails, the user
+	// might be on any page of the results.
+	for page := 0; ; page++ {
+		var out struct {
+			Data []struct {
+				ID         string `json:"id"`
+				Attributes struct {
+					Handle string `json:"handle"`
+				} `json:"attributes"`
+			} `json:"data"`
+		}
+		query := url.Values{
+			"filter":       []string{handle},
+			"page[size]":   []string{strconv.Itoa(usersV2PageSize)},
+			"page[number]": []string{strconv.Itoa(page)},
+		}
+		if err := doDatadogRequest(client, "GET", "/v2/users", query, nil, &out); err != nil {
+			return "", err
+		}
+		for _, u := range out.Data {
+			if u.Attributes.Handle == handle {
+				return u.ID, nil
+			}
+		}
+		if len(out.Data) < usersV2PageSize {
+			return "", nil
+		}
+	}
+}
+
+func deleteUserV2(client *datadog.Client, id string) error {
+	return doDatadogRequest(client, "DELETE", fmt.Sprintf("/v2/users/%s", id), nil, nil, nil)
+}